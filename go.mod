@@ -3,13 +3,18 @@ module github.com/jecitDev/jec-go-helper
 go 1.21.0
 
 require (
+	github.com/alicebob/miniredis/v2 v2.39.0
 	github.com/go-playground/validator/v10 v10.18.0
+	github.com/google/uuid v1.6.0
 	github.com/jmoiron/sqlx v1.3.5
 	github.com/lib/pq v1.10.9
 	github.com/newrelic/go-agent/v3/integrations/nrpq v1.1.1
 	github.com/newrelic/go-agent/v3/integrations/nrredis-v9 v1.0.0
+	github.com/nyaruka/phonenumbers v1.3.4
 	github.com/redis/go-redis/v9 v9.5.1
+	github.com/santhosh-tekuri/jsonschema/v5 v5.3.1
 	golang.org/x/crypto v0.19.0
+	golang.org/x/text v0.14.0
 	google.golang.org/grpc v1.62.0
 )
 
@@ -22,9 +27,9 @@ require (
 	github.com/golang/protobuf v1.5.3 // indirect
 	github.com/leodido/go-urn v1.4.0 // indirect
 	github.com/newrelic/go-agent/v3 v3.20.4 // indirect
+	github.com/yuin/gopher-lua v1.1.1 // indirect
 	golang.org/x/net v0.21.0 // indirect
 	golang.org/x/sys v0.17.0 // indirect
-	golang.org/x/text v0.14.0 // indirect
 	google.golang.org/genproto/googleapis/rpc v0.0.0-20240123012728-ef4313101c80 // indirect
-	google.golang.org/protobuf v1.32.0 // indirect
+	google.golang.org/protobuf v1.33.0 // indirect
 )
@@ -1,30 +1,184 @@
 package iso8601date
 
 import (
+	"database/sql/driver"
 	"encoding/json"
 	"fmt"
 	"regexp"
+	"time"
 )
 
 // type ISO8601date ISO8601dateData
 
+// Layout matching the accepted format, always with a numeric offset (never "Z").
+const layout = "2006-01-02T15:04:05-07:00"
+
+const dateLayout = "2006-01-02"
+
+var iso8601DateRegex = regexp.MustCompile(`^(\d{4})-(\d{2})-(\d{2})T(\d{2}):(\d{2}):(\d{2})([+-])(\d{2}):(\d{2})$`)
+
+var iso8601DateOnlyRegex = regexp.MustCompile(`^(\d{4})-(\d{2})-(\d{2})$`)
+
+// Layouts tried by ParseDateTime when no formats are given.
+var defaultDateTimeFormats = []string{
+	layout,
+	time.RFC3339,
+	time.RFC3339Nano,
+	"2006-01-02T15:04:05",
+	"2006-01-02 15:04:05",
+}
+
 type ISO8601date struct {
 	datetime string
+	// Parsed form of datetime, filled in when the value is created. Kept as
+	// plain numbers rather than a time.Time so the struct stays comparable
+	// with == and usable as a map key.
+	unix   int64
+	offset int
+	parsed bool
+	// set for values created from a YYYY-MM-DD string
+	dateOnly bool
 }
 
 func (c ISO8601date) String() string {
 	return string(c.datetime)
 }
 func Parse(s string) (ISO8601date, error) {
-	ISO8601DateRegexString := `^(\d{4})-(\d{2})-(\d{2})T(\d{2}):(\d{2}):(\d{2})([+-])(\d{2}):(\d{2})$`
-	ISO8601DateRegex := regexp.MustCompile(ISO8601DateRegexString)
+	if iso8601DateRegex.MatchString(s) {
+		return newISO8601date(s), nil
+	}
+	return ISO8601date{}, fmt.Errorf("validation_request|not_iso8601date|%s", "Data")
+
+}
+
+// ParseDate accepts a YYYY-MM-DD date. String keeps returning the date as
+// given, while ToTime and the comparison methods treat it as T00:00:00+00:00.
+func ParseDate(s string) (ISO8601date, error) {
+	if !iso8601DateOnlyRegex.MatchString(s) {
+		return ISO8601date{}, fmt.Errorf("validation_request|not_iso8601date|%s", "Data")
+	}
+	t, err := time.Parse(dateLayout, s)
+	if err != nil {
+		return ISO8601date{}, fmt.Errorf("validation_request|not_iso8601date|%s", "Data")
+	}
+	c := ISO8601date{datetime: s, dateOnly: true}
+	c.setTime(t)
+	return c, nil
+}
 
-	if ISO8601DateRegex.MatchString(s) {
-		return ISO8601date{s}, nil
+// ParseDateTime tries each layout in turn, defaulting to the ISO8601 layout,
+// RFC3339, RFC3339Nano and variants without a timezone (read as UTC). A plain
+// YYYY-MM-DD value is always accepted as a date-only value.
+func ParseDateTime(s string, formats ...string) (ISO8601date, error) {
+	if iso8601DateOnlyRegex.MatchString(s) {
+		return ParseDate(s)
+	}
+	if len(formats) == 0 {
+		formats = defaultDateTimeFormats
+	}
+	for _, f := range formats {
+		if t, err := time.Parse(f, s); err == nil {
+			return fromTime(t), nil
+		}
 	}
 	return ISO8601date{}, fmt.Errorf("validation_request|not_iso8601date|%s", "Data")
+}
+
+func (c ISO8601date) IsDateOnly() bool {
+	return c.dateOnly
+}
+
+// MustParse is like Parse but panics on invalid input. Meant for test fixtures.
+func MustParse(s string) ISO8601date {
+	c, err := Parse(s)
+	if err != nil {
+		panic(err)
+	}
+	return c
+}
+
+func newISO8601date(s string) ISO8601date {
+	c := ISO8601date{datetime: s}
+	if t, err := time.Parse(layout, s); err == nil {
+		c.setTime(t)
+	}
+	return c
+}
+
+func fromTime(t time.Time) ISO8601date {
+	t = t.Truncate(time.Second)
+	c := ISO8601date{datetime: t.Format(layout)}
+	c.setTime(t)
+	return c
+}
+
+func (c *ISO8601date) setTime(t time.Time) {
+	_, c.offset = t.Zone()
+	c.unix = t.Unix()
+	c.parsed = true
+}
+
+func (c ISO8601date) ToTime() (time.Time, error) {
+	if c.parsed {
+		return time.Unix(c.unix, 0).In(time.FixedZone("", c.offset)), nil
+	}
+	t, err := time.Parse(layout, c.datetime)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("validation_request|not_iso8601date|%s", "Data")
+	}
+	return t, nil
+}
+
+// Unparseable values compare as the zero time.
+func (c ISO8601date) Before(other ISO8601date) bool {
+	t, _ := c.ToTime()
+	o, _ := other.ToTime()
+	return t.Before(o)
+}
+
+func (c ISO8601date) After(other ISO8601date) bool {
+	t, _ := c.ToTime()
+	o, _ := other.ToTime()
+	return t.After(o)
+}
+
+// Equal compares instants, so values with different offsets can be equal.
+func (c ISO8601date) Equal(other ISO8601date) bool {
+	t, _ := c.ToTime()
+	o, _ := other.ToTime()
+	return t.Equal(o)
+}
+
+// Add keeps the original offset and truncates the result to whole seconds.
+func (c ISO8601date) Add(d time.Duration) (ISO8601date, error) {
+	t, err := c.ToTime()
+	if err != nil {
+		return ISO8601date{}, err
+	}
+	return fromTime(t.Add(d)), nil
+}
+
+func (c ISO8601date) Sub(other ISO8601date) (time.Duration, error) {
+	t, err := c.ToTime()
+	if err != nil {
+		return 0, err
+	}
+	o, err := other.ToTime()
+	if err != nil {
+		return 0, err
+	}
+	return t.Sub(o), nil
+}
 
+// Format returns an empty string when the value cannot be parsed.
+func (c ISO8601date) Format(layout string) string {
+	t, err := c.ToTime()
+	if err != nil {
+		return ""
+	}
+	return t.Format(layout)
 }
+
 func (c ISO8601date) MarshalJSON() ([]byte, error) {
 	return json.Marshal(c.datetime)
 }
@@ -34,8 +188,75 @@ func (c *ISO8601date) UnmarshalJSON(data []byte) error {
 	if err := json.Unmarshal(data, &s); err != nil {
 		return err
 	}
-	*c = ISO8601date{
-		datetime: s,
+	// The raw string is always kept so the ISO8601date validation tag sees
+	// exactly what the client sent.
+	if d, err := ParseDate(s); err == nil {
+		*c = d
+		return nil
+	}
+	*c = newISO8601date(s)
+	return nil
+}
+
+func (c *ISO8601date) Scan(src interface{}) error {
+	var s string
+	switch v := src.(type) {
+	case nil:
+		*c = ISO8601date{}
+		return nil
+	case string:
+		s = v
+	case []byte:
+		s = string(v)
+	case time.Time:
+		*c = fromTime(v)
+		return nil
+	default:
+		return fmt.Errorf("iso8601date: cannot scan %T", src)
+	}
+
+	d, err := ParseDateTime(s)
+	if err != nil {
+		return err
+	}
+	*c = d
+	return nil
+}
+
+func (c ISO8601date) Ptr() *ISO8601date {
+	return &c
+}
+
+// Value stores the ISO8601 string, or YYYY-MM-DD for date-only values. The
+// zero value is stored as NULL, which Scan reads back as the zero value.
+func (c ISO8601date) Value() (driver.Value, error) {
+	if c.datetime == "" {
+		return nil, nil
+	}
+	return c.datetime, nil
+}
+
+// NullISO8601date mirrors sql.NullString for nullable date columns.
+type NullISO8601date struct {
+	ISO8601date ISO8601date
+	Valid       bool
+}
+
+func (n *NullISO8601date) Scan(src interface{}) error {
+	if src == nil {
+		n.ISO8601date, n.Valid = ISO8601date{}, false
+		return nil
 	}
+	if err := n.ISO8601date.Scan(src); err != nil {
+		return err
+	}
+	n.Valid = true
 	return nil
 }
+
+func (n NullISO8601date) Value() (driver.Value, error) {
+	if !n.Valid {
+		return nil, nil
+	}
+	return n.ISO8601date.Value()
+}
@@ -0,0 +1,251 @@
+package iso8601date
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+	_ "time/tzdata"
+)
+
+func TestComparable(t *testing.T) {
+	const s = "2024-01-01T00:00:00+05:30"
+	if MustParse(s) != MustParse(s) {
+		t.Fatal("values parsed from the same string are not ==")
+	}
+
+	seen := map[ISO8601date]bool{MustParse(s): true}
+	if !seen[MustParse(s)] {
+		t.Fatal("value is not usable as a map key")
+	}
+
+	ist := time.FixedZone("IST", 5*60*60+30*60)
+	if fromTime(time.Date(2024, 1, 1, 0, 0, 0, 0, ist)) != MustParse(s) {
+		t.Fatal("value built from time.Time is not == to the parsed value")
+	}
+}
+
+func TestCompareAcrossDST(t *testing.T) {
+	ny, err := time.LoadLocation("America/New_York")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// 2024-03-10 02:00 EST jumps to 03:00 EDT.
+	before := fromTime(time.Date(2024, 3, 10, 1, 59, 59, 0, ny))
+	after := fromTime(time.Date(2024, 3, 10, 3, 0, 0, 0, ny))
+
+	if got, want := before.String(), "2024-03-10T01:59:59-05:00"; got != want {
+		t.Fatalf("before = %s, want %s", got, want)
+	}
+	if got, want := after.String(), "2024-03-10T03:00:00-04:00"; got != want {
+		t.Fatalf("after = %s, want %s", got, want)
+	}
+	if !before.Before(after) || !after.After(before) {
+		t.Fatal("ordering across the DST jump is wrong")
+	}
+	d, err := after.Sub(before)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if d != time.Second {
+		t.Fatalf("Sub = %s, want 1s", d)
+	}
+}
+
+func TestAddKeepsOffsetAcrossDST(t *testing.T) {
+	// Adding across the New York spring-forward instant keeps the stored
+	// offset; the value is a fixed-offset timestamp, not a wall clock.
+	start := MustParse("2024-03-10T01:30:00-05:00")
+	got, err := start.Add(time.Hour)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := "2024-03-10T02:30:00-05:00"; got.String() != want {
+		t.Fatalf("Add = %s, want %s", got, want)
+	}
+	if !got.Equal(MustParse("2024-03-10T03:30:00-04:00")) {
+		t.Fatal("result is not the same instant as 03:30 EDT")
+	}
+}
+
+func TestSubSecondTruncation(t *testing.T) {
+	bkk := time.FixedZone("", 7*60*60)
+	withNanos := time.Date(2024, 1, 1, 10, 0, 0, 999_999_999, bkk)
+
+	var c ISO8601date
+	if err := c.Scan(withNanos); err != nil {
+		t.Fatal(err)
+	}
+	if want := "2024-01-01T10:00:00+07:00"; c.String() != want {
+		t.Fatalf("Scan = %s, want %s", c, want)
+	}
+
+	added, err := MustParse("2024-01-01T10:00:00+07:00").Add(1500 * time.Millisecond)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := "2024-01-01T10:00:01+07:00"; added.String() != want {
+		t.Fatalf("Add = %s, want %s", added, want)
+	}
+
+	parsed, err := ParseDateTime("2024-01-01T10:00:00.75+07:00")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if parsed != MustParse("2024-01-01T10:00:00+07:00") {
+		t.Fatalf("ParseDateTime = %s, fractional seconds were not truncated", parsed)
+	}
+}
+
+func TestEqualDifferentOffsets(t *testing.T) {
+	a := MustParse("2024-01-01T07:00:00+07:00")
+	b := MustParse("2024-01-01T00:00:00+00:00")
+	if !a.Equal(b) {
+		t.Fatal("same instant with different offsets should be Equal")
+	}
+	if a == b {
+		t.Fatal("different offsets should not be ==")
+	}
+}
+
+func TestUnmarshalDateOnlyKeepsInput(t *testing.T) {
+	var c ISO8601date
+	if err := json.Unmarshal([]byte(`"2024-01-01"`), &c); err != nil {
+		t.Fatal(err)
+	}
+	if c.String() != "2024-01-01" {
+		t.Fatalf("String = %q, want the raw input", c.String())
+	}
+	if !c.IsDateOnly() {
+		t.Fatal("expected a date-only value")
+	}
+	if !c.Equal(MustParse("2024-01-01T00:00:00+00:00")) {
+		t.Fatal("date-only value should compare as midnight UTC")
+	}
+	out, err := json.Marshal(c)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(out) != `"2024-01-01"` {
+		t.Fatalf("MarshalJSON = %s", out)
+	}
+
+	if err := json.Unmarshal([]byte(`"2024-13-45"`), &c); err != nil {
+		t.Fatal(err)
+	}
+	if c.String() != "2024-13-45" || c.IsDateOnly() {
+		t.Fatalf("invalid input should be kept raw for validation, got %q", c.String())
+	}
+}
+
+func TestAddDurationKeepsDateOnlyString(t *testing.T) {
+	d, err := ParseDate("2024-01-10")
+	if err != nil {
+		t.Fatal(err)
+	}
+	got, err := d.AddDuration(Duration{Days: 5})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got.String() != "2024-01-15" || !got.IsDateOnly() {
+		t.Fatalf("AddDuration = %q, dateOnly=%v", got.String(), got.IsDateOnly())
+	}
+}
+
+func TestScan(t *testing.T) {
+	tests := []struct {
+		name     string
+		src      interface{}
+		want     string
+		dateOnly bool
+	}{
+		{"string", "2024-01-01T10:00:00+07:00", "2024-01-01T10:00:00+07:00", false},
+		{"bytes", []byte("2024-01-01T10:00:00+07:00"), "2024-01-01T10:00:00+07:00", false},
+		{"RFC3339 UTC string", "2024-01-01T03:00:00Z", "2024-01-01T03:00:00+00:00", false},
+		{"date-only string", "2024-01-01", "2024-01-01", true},
+		{"date-only bytes", []byte("2024-02-29"), "2024-02-29", true},
+		{"time.Time", time.Date(2024, 1, 1, 10, 0, 0, 0, time.FixedZone("", 7*60*60)), "2024-01-01T10:00:00+07:00", false},
+		{"nil", nil, "", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := MustParse("2000-01-01T00:00:00+00:00")
+			if err := c.Scan(tt.src); err != nil {
+				t.Fatal(err)
+			}
+			if c.String() != tt.want || c.IsDateOnly() != tt.dateOnly {
+				t.Fatalf("Scan = %q (dateOnly=%v), want %q (dateOnly=%v)", c, c.IsDateOnly(), tt.want, tt.dateOnly)
+			}
+		})
+	}
+
+	for _, src := range []interface{}{"", "not a date", []byte("2024-13-01"), 42} {
+		var c ISO8601date
+		if err := c.Scan(src); err == nil {
+			t.Errorf("Scan(%#v) succeeded, want an error", src)
+		}
+	}
+}
+
+func TestValue(t *testing.T) {
+	v, err := MustParse("2024-01-01T10:00:00+07:00").Value()
+	if err != nil || v != "2024-01-01T10:00:00+07:00" {
+		t.Fatalf("Value = %#v, %v", v, err)
+	}
+
+	d, err := ParseDate("2024-01-01")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if v, err := d.Value(); err != nil || v != "2024-01-01" {
+		t.Fatalf("date-only Value = %#v, %v", v, err)
+	}
+
+	v, err = ISO8601date{}.Value()
+	if err != nil || v != nil {
+		t.Fatalf("zero Value = %#v, %v, want nil", v, err)
+	}
+	var back ISO8601date
+	if err := back.Scan(v); err != nil || back != (ISO8601date{}) {
+		t.Fatalf("zero value round trip = %q, %v", back, err)
+	}
+}
+
+func TestNullISO8601date(t *testing.T) {
+	var n NullISO8601date
+	if err := n.Scan("2024-01-01T10:00:00+07:00"); err != nil {
+		t.Fatal(err)
+	}
+	if !n.Valid || n.ISO8601date != MustParse("2024-01-01T10:00:00+07:00") {
+		t.Fatalf("Scan = %+v", n)
+	}
+	if v, err := n.Value(); err != nil || v != "2024-01-01T10:00:00+07:00" {
+		t.Fatalf("Value = %#v, %v", v, err)
+	}
+
+	if err := n.Scan([]byte("2024-01-01")); err != nil {
+		t.Fatal(err)
+	}
+	if !n.Valid || !n.ISO8601date.IsDateOnly() {
+		t.Fatalf("date-only Scan = %+v", n)
+	}
+
+	if err := n.Scan(nil); err != nil {
+		t.Fatal(err)
+	}
+	if n.Valid || n.ISO8601date != (ISO8601date{}) {
+		t.Fatalf("Scan(nil) = %+v", n)
+	}
+	if v, err := n.Value(); err != nil || v != nil {
+		t.Fatalf("NULL Value = %#v, %v", v, err)
+	}
+
+	bad := NullISO8601date{Valid: true}
+	if err := bad.Scan("garbage"); err == nil {
+		t.Fatal("expected an error scanning an invalid string")
+	}
+	if v, err := (NullISO8601date{Valid: false, ISO8601date: MustParse("2024-01-01T10:00:00+07:00")}).Value(); err != nil || v != nil {
+		t.Fatalf("invalid NullISO8601date Value = %#v, %v, want nil", v, err)
+	}
+}
@@ -0,0 +1,152 @@
+package iso8601date
+
+import (
+	"fmt"
+	"math"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+)
+
+var iso8601DurationRegex = regexp.MustCompile(`^P(?:(\d+)Y)?(?:(\d+)M)?(?:(\d+)W)?(?:(\d+)D)?(?:T(?:(\d+)H)?(?:(\d+)M)?(?:(\d+(?:[.,]\d+)?)S)?)?$`)
+
+// Duration is an ISO 8601 duration such as P1Y2M3DT4H5M6S. Weeks (PnW) are
+// folded into Days when parsing.
+type Duration struct {
+	Years   int
+	Months  int
+	Days    int
+	Hours   int
+	Minutes int
+	Seconds float64
+}
+
+func ParseDuration(s string) (Duration, error) {
+	m := iso8601DurationRegex.FindStringSubmatch(s)
+	if m == nil || s == "P" || strings.HasSuffix(s, "T") {
+		return Duration{}, notDurationError()
+	}
+
+	// Years, months, weeks, days, hours, minutes; an empty group is zero.
+	var n [6]int
+	for i, v := range m[1:7] {
+		if v == "" {
+			continue
+		}
+		value, err := strconv.Atoi(v)
+		if err != nil {
+			return Duration{}, notDurationError()
+		}
+		n[i] = value
+	}
+	if n[2] > (math.MaxInt-n[3])/7 {
+		return Duration{}, notDurationError()
+	}
+
+	d := Duration{
+		Years:   n[0],
+		Months:  n[1],
+		Days:    n[2]*7 + n[3],
+		Hours:   n[4],
+		Minutes: n[5],
+	}
+	if m[7] != "" {
+		seconds, err := strconv.ParseFloat(strings.Replace(m[7], ",", ".", 1), 64)
+		if err != nil {
+			return Duration{}, notDurationError()
+		}
+		d.Seconds = seconds
+	}
+	return d, nil
+}
+
+func notDurationError() error {
+	return fmt.Errorf("validation_request|not_iso8601duration|%s", "Data")
+}
+
+// ToDuration approximates a month as 30 days and a year as 365 days.
+func (d Duration) ToDuration() (time.Duration, error) {
+	days := float64(d.Years)*365 + float64(d.Months)*30 + float64(d.Days)
+	total := days*24*float64(time.Hour) +
+		float64(d.Hours)*float64(time.Hour) +
+		float64(d.Minutes)*float64(time.Minute) +
+		d.Seconds*float64(time.Second)
+	if total > math.MaxInt64 {
+		return 0, fmt.Errorf("iso8601date: duration %s overflows time.Duration", d)
+	}
+	return time.Duration(total), nil
+}
+
+func (d Duration) String() string {
+	var sb strings.Builder
+	sb.WriteString("P")
+	if d.Years != 0 {
+		sb.WriteString(strconv.Itoa(d.Years) + "Y")
+	}
+	if d.Months != 0 {
+		sb.WriteString(strconv.Itoa(d.Months) + "M")
+	}
+	if d.Days != 0 {
+		sb.WriteString(strconv.Itoa(d.Days) + "D")
+	}
+	if d.hasTime() {
+		sb.WriteString("T")
+		if d.Hours != 0 {
+			sb.WriteString(strconv.Itoa(d.Hours) + "H")
+		}
+		if d.Minutes != 0 {
+			sb.WriteString(strconv.Itoa(d.Minutes) + "M")
+		}
+		if d.Seconds != 0 {
+			sb.WriteString(strconv.FormatFloat(d.Seconds, 'f', -1, 64) + "S")
+		}
+	}
+	if sb.Len() == 1 {
+		return "PT0S"
+	}
+	return sb.String()
+}
+
+func (d Duration) hasTime() bool {
+	return d.Hours != 0 || d.Minutes != 0 || d.Seconds != 0
+}
+
+// AddDuration uses calendar arithmetic for years, months and days. When the
+// target month is shorter the day is clamped to its last day, so P1M added to
+// Jan 31 gives Feb 29 (or 28) rather than spilling into March. Years and months
+// are applied before days and the time part. A date-only value stays date-only
+// when the duration has no time component.
+func (c ISO8601date) AddDuration(d Duration) (ISO8601date, error) {
+	t, err := c.ToTime()
+	if err != nil {
+		return ISO8601date{}, err
+	}
+
+	t = addMonthsClamped(t, d.Years*12+d.Months).
+		AddDate(0, 0, d.Days).
+		Add(time.Duration(d.Hours)*time.Hour +
+			time.Duration(d.Minutes)*time.Minute +
+			time.Duration(d.Seconds*float64(time.Second)))
+
+	result := fromTime(t)
+	if c.dateOnly && !d.hasTime() {
+		result.datetime = t.Format(dateLayout)
+		result.dateOnly = true
+	}
+	return result, nil
+}
+
+func addMonthsClamped(t time.Time, months int) time.Time {
+	if months == 0 {
+		return t
+	}
+	year, month, day := t.Date()
+	hour, minute, sec := t.Clock()
+	// Day 0 of the following month is the last day of the target month.
+	lastDay := time.Date(year, month+time.Month(months)+1, 0, 0, 0, 0, 0, t.Location()).Day()
+	if day > lastDay {
+		day = lastDay
+	}
+	return time.Date(year, month+time.Month(months), day, hour, minute, sec, t.Nanosecond(), t.Location())
+}
@@ -0,0 +1,168 @@
+package iso8601date
+
+import (
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestAddDurationClampsMonthEnd(t *testing.T) {
+	tests := []struct {
+		start    string
+		duration string
+		want     string
+	}{
+		{"2024-01-31T10:00:00+07:00", "P1M", "2024-02-29T10:00:00+07:00"},
+		{"2023-01-31T10:00:00+07:00", "P1M", "2023-02-28T10:00:00+07:00"},
+		{"2024-03-31T10:00:00+07:00", "P1M", "2024-04-30T10:00:00+07:00"},
+		{"2024-02-29T10:00:00+07:00", "P1Y", "2025-02-28T10:00:00+07:00"},
+		{"2024-01-31T10:00:00+07:00", "P1M1D", "2024-03-01T10:00:00+07:00"},
+		{"2024-11-30T10:00:00+07:00", "P3M", "2025-02-28T10:00:00+07:00"},
+		{"2024-01-15T10:00:00+07:00", "P1MT2H", "2024-02-15T12:00:00+07:00"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.start+"+"+tt.duration, func(t *testing.T) {
+			d, err := ParseDuration(tt.duration)
+			if err != nil {
+				t.Fatal(err)
+			}
+			got, err := MustParse(tt.start).AddDuration(d)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got.String() != tt.want {
+				t.Fatalf("got %s, want %s", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestParseDuration(t *testing.T) {
+	tests := []struct {
+		in   string
+		want Duration
+	}{
+		{"P1Y2M3DT4H5M6S", Duration{Years: 1, Months: 2, Days: 3, Hours: 4, Minutes: 5, Seconds: 6}},
+		{"P2W", Duration{Days: 14}},
+		{"P1W3D", Duration{Days: 10}},
+		{"PT36H", Duration{Hours: 36}},
+		{"PT0S", Duration{}},
+		{"PT1.5S", Duration{Seconds: 1.5}},
+		{"PT1,25S", Duration{Seconds: 1.25}},
+		{"P1MT1M", Duration{Months: 1, Minutes: 1}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.in, func(t *testing.T) {
+			got, err := ParseDuration(tt.in)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got != tt.want {
+				t.Fatalf("got %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestParseDurationErrors(t *testing.T) {
+	for _, in := range []string{
+		"",
+		"P",
+		"PT",
+		"P1DT",
+		"1D",
+		"P1H",
+		"PT1D",
+		"P-1D",
+		"P1.5D",
+		"PT1.S",
+		"P1D2Y",
+		"P99999999999999999999D",
+		"P1317624576693539402W",
+		"P1317624576693539401W7D",
+	} {
+		t.Run(in, func(t *testing.T) {
+			_, err := ParseDuration(in)
+			if err == nil || !strings.Contains(err.Error(), "not_iso8601duration") {
+				t.Fatalf("ParseDuration(%q) err = %v, want not_iso8601duration", in, err)
+			}
+		})
+	}
+}
+
+func TestDurationStringRoundTrip(t *testing.T) {
+	tests := []struct {
+		in, want string
+	}{
+		{"P1Y2M3DT4H5M6S", "P1Y2M3DT4H5M6S"},
+		{"P2W", "P14D"},
+		{"PT1,5S", "PT1.5S"},
+		{"PT0.25S", "PT0.25S"},
+		{"P0D", "PT0S"},
+		{"PT0S", "PT0S"},
+		{"P1MT1M", "P1MT1M"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.in, func(t *testing.T) {
+			d, err := ParseDuration(tt.in)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got := d.String(); got != tt.want {
+				t.Fatalf("String = %s, want %s", got, tt.want)
+			}
+			back, err := ParseDuration(d.String())
+			if err != nil {
+				t.Fatal(err)
+			}
+			if back != d {
+				t.Fatalf("round trip = %+v, want %+v", back, d)
+			}
+		})
+	}
+}
+
+func TestDurationToDuration(t *testing.T) {
+	const day = 24 * time.Hour
+
+	tests := []struct {
+		in   string
+		want time.Duration
+	}{
+		{"PT1H30M", 90 * time.Minute},
+		{"PT0.5S", 500 * time.Millisecond},
+		{"P1D", day},
+		{"P1W", 7 * day},
+		{"P1M", 30 * day},
+		{"P1Y", 365 * day},
+		{"P1Y1M1DT1H1M1S", 396*day + time.Hour + time.Minute + time.Second},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.in, func(t *testing.T) {
+			got, err := mustParseDuration(t, tt.in).ToDuration()
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got != tt.want {
+				t.Fatalf("ToDuration = %s, want %s", got, tt.want)
+			}
+		})
+	}
+
+	if _, err := (Duration{Years: 300}).ToDuration(); err == nil {
+		t.Fatal("expected an overflow error for 300 years")
+	}
+}
+
+func mustParseDuration(t *testing.T, s string) Duration {
+	t.Helper()
+	d, err := ParseDuration(s)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return d
+}
@@ -0,0 +1,72 @@
+package jsoncolumn
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"encoding/json"
+	"errors"
+	"fmt"
+)
+
+var ErrIndexOutOfRange = errors.New("jsoncolumn: index out of range")
+
+var (
+	_ sql.Scanner   = (*JsonArrayColumn[any])(nil)
+	_ driver.Valuer = (*JsonArrayColumn[any])(nil)
+)
+
+// JsonArrayColumn maps a JSON array column (text, json or jsonb) to []T.
+type JsonArrayColumn[T any] struct {
+	V []T
+}
+
+func (j *JsonArrayColumn[T]) Scan(src any) error {
+	var raw []byte
+	switch v := src.(type) {
+	case nil:
+		j.V = nil
+		return nil
+	case []byte:
+		raw = v
+	case string:
+		raw = []byte(v)
+	default:
+		return fmt.Errorf("jsoncolumn: cannot scan %T into JsonArrayColumn", src)
+	}
+
+	var values []T
+	if err := json.Unmarshal(raw, &values); err != nil {
+		return err
+	}
+	j.V = values
+	return nil
+}
+
+func (j *JsonArrayColumn[T]) Value() (driver.Value, error) {
+	raw, err := json.Marshal(j.V)
+	return raw, err
+}
+
+func (j *JsonArrayColumn[T]) Append(item T) {
+	j.V = append(j.V, item)
+}
+
+func (j *JsonArrayColumn[T]) Remove(index int) error {
+	if index < 0 || index >= len(j.V) {
+		return ErrIndexOutOfRange
+	}
+	j.V = append(j.V[:index], j.V[index+1:]...)
+	return nil
+}
+
+func (j *JsonArrayColumn[T]) Get(index int) (T, error) {
+	if index < 0 || index >= len(j.V) {
+		var zero T
+		return zero, ErrIndexOutOfRange
+	}
+	return j.V[index], nil
+}
+
+func (j *JsonArrayColumn[T]) Len() int {
+	return len(j.V)
+}
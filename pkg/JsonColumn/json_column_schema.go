@@ -0,0 +1,141 @@
+package jsoncolumn
+
+import (
+	"bytes"
+	"database/sql/driver"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"reflect"
+	"strings"
+	"sync"
+
+	"github.com/santhosh-tekuri/jsonschema/v5"
+)
+
+var ErrNoSchema = errors.New("jsoncolumn: no schema registered for this type")
+
+var (
+	schemaRegistryMu sync.RWMutex
+	schemaRegistry   = map[reflect.Type]*jsonschema.Schema{}
+)
+
+// JsonColumnWithSchema is a JsonColumn that checks the JSON against a JSON
+// Schema on both Scan and Value. Values scanned by sqlx are zero valued, so
+// the schema for T is normally registered once with RegisterSchema.
+type JsonColumnWithSchema[T any] struct {
+	JsonColumn[T]
+	// Skips schema checks, e.g. for bulk loads of already trusted data.
+	SkipValidation bool
+	schema         *jsonschema.Schema
+}
+
+// RegisterSchema sets the schema used by every JsonColumnWithSchema[T] that
+// was not created with NewJsonColumnWithSchema. Call it during start-up.
+func RegisterSchema[T any](schema string) error {
+	compiled, err := compileSchema(schema)
+	if err != nil {
+		return err
+	}
+	schemaRegistryMu.Lock()
+	schemaRegistry[reflect.TypeOf((*T)(nil)).Elem()] = compiled
+	schemaRegistryMu.Unlock()
+	return nil
+}
+
+func NewJsonColumnWithSchema[T any](schema string) (*JsonColumnWithSchema[T], error) {
+	compiled, err := compileSchema(schema)
+	if err != nil {
+		return nil, err
+	}
+	return &JsonColumnWithSchema[T]{schema: compiled}, nil
+}
+
+func compileSchema(schema string) (*jsonschema.Schema, error) {
+	compiled, err := jsonschema.CompileString("schema.json", schema)
+	if err != nil {
+		return nil, fmt.Errorf("jsoncolumn: compile schema: %w", err)
+	}
+	return compiled, nil
+}
+
+// Returns the schema given to NewJsonColumnWithSchema, else the registered one.
+func (j *JsonColumnWithSchema[T]) resolveSchema() *jsonschema.Schema {
+	if j.schema != nil {
+		return j.schema
+	}
+	schemaRegistryMu.RLock()
+	defer schemaRegistryMu.RUnlock()
+	return schemaRegistry[reflect.TypeOf((*T)(nil)).Elem()]
+}
+
+// SQL NULL is not checked against the schema. The column contents are
+// validated as stored, before decoding into T, and values failing the schema
+// are discarded, leaving V nil.
+func (j *JsonColumnWithSchema[T]) Scan(src any) error {
+	var raw []byte
+	switch v := src.(type) {
+	case nil:
+		j.V = nil
+		return nil
+	case []byte:
+		raw = v
+	case string:
+		raw = []byte(v)
+	default:
+		return fmt.Errorf("jsoncolumn: cannot scan %T into JsonColumnWithSchema", src)
+	}
+
+	if err := j.validate(raw); err != nil {
+		j.V = nil
+		return err
+	}
+	return j.JsonColumn.Scan(raw)
+}
+
+func (j *JsonColumnWithSchema[T]) Value() (driver.Value, error) {
+	raw, err := json.Marshal(j.V)
+	if err != nil {
+		return nil, err
+	}
+	if err := j.validate(raw); err != nil {
+		return nil, err
+	}
+	return raw, nil
+}
+
+func (j *JsonColumnWithSchema[T]) validate(raw []byte) error {
+	if j.SkipValidation {
+		return nil
+	}
+	schema := j.resolveSchema()
+	if schema == nil {
+		return ErrNoSchema
+	}
+
+	var doc interface{}
+	decoder := json.NewDecoder(bytes.NewReader(raw))
+	decoder.UseNumber()
+	if err := decoder.Decode(&doc); err != nil {
+		return err
+	}
+
+	err := schema.Validate(doc)
+	var validationErr *jsonschema.ValidationError
+	if !errors.As(err, &validationErr) {
+		return err
+	}
+
+	var violations []string
+	for _, e := range validationErr.BasicOutput().Errors {
+		if e.Error == "" || strings.HasPrefix(e.Error, "doesn't validate with") {
+			continue
+		}
+		location := e.InstanceLocation
+		if location == "" {
+			location = "/"
+		}
+		violations = append(violations, fmt.Sprintf("%s: %s", location, e.Error))
+	}
+	return fmt.Errorf("jsoncolumn: schema validation failed: %s", strings.Join(violations, "; "))
+}
@@ -0,0 +1,80 @@
+package jsoncolumn
+
+import (
+	"errors"
+	"strings"
+	"testing"
+)
+
+type registeredProfile struct {
+	Name string `json:"name"`
+	Age  int    `json:"age"`
+}
+
+type unregisteredProfile struct {
+	Name string `json:"name"`
+}
+
+const profileSchema = `{
+	"type": "object",
+	"required": ["name"],
+	"properties": {"name": {"type": "string", "minLength": 1}, "age": {"type": "integer", "minimum": 0}}
+}`
+
+func TestJsonColumnWithSchemaRegistry(t *testing.T) {
+	if err := RegisterSchema[registeredProfile](profileSchema); err != nil {
+		t.Fatal(err)
+	}
+
+	// Zero value, as produced by sqlx.Select, picks up the registered schema.
+	var col JsonColumnWithSchema[registeredProfile]
+	if err := col.Scan([]byte(`{"name":"Somchai","age":40}`)); err != nil {
+		t.Fatalf("valid document: %v", err)
+	}
+	if col.V == nil || col.V.Name != "Somchai" {
+		t.Fatalf("V = %+v", col.V)
+	}
+
+	var bad JsonColumnWithSchema[registeredProfile]
+	err := bad.Scan([]byte(`{"name":"","age":-1}`))
+	if err == nil || !strings.Contains(err.Error(), "schema validation failed") {
+		t.Fatalf("invalid document: err = %v", err)
+	}
+	if bad.V != nil {
+		t.Fatal("invalid document should leave V nil")
+	}
+
+	bad.V = &registeredProfile{Age: -1}
+	if _, err := bad.Value(); err == nil {
+		t.Fatal("Value should reject an invalid document")
+	}
+}
+
+func TestJsonColumnWithSchemaRequiresSchema(t *testing.T) {
+	var col JsonColumnWithSchema[unregisteredProfile]
+	if err := col.Scan([]byte(`{"name":"x"}`)); !errors.Is(err, ErrNoSchema) {
+		t.Fatalf("Scan err = %v, want ErrNoSchema", err)
+	}
+	col.V = &unregisteredProfile{Name: "x"}
+	if _, err := col.Value(); !errors.Is(err, ErrNoSchema) {
+		t.Fatalf("Value err = %v, want ErrNoSchema", err)
+	}
+
+	skip := JsonColumnWithSchema[unregisteredProfile]{SkipValidation: true}
+	if err := skip.Scan([]byte(`{"name":"x"}`)); err != nil {
+		t.Fatalf("SkipValidation Scan: %v", err)
+	}
+}
+
+func TestNewJsonColumnWithSchemaOverridesRegistry(t *testing.T) {
+	col, err := NewJsonColumnWithSchema[unregisteredProfile](`{"type":"object","required":["name"]}`)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := col.Scan([]byte(`{}`)); err == nil {
+		t.Fatal("expected the explicit schema to be applied")
+	}
+	if err := col.Scan([]byte(`{"name":"x"}`)); err != nil {
+		t.Fatal(err)
+	}
+}
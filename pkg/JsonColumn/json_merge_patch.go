@@ -0,0 +1,129 @@
+package jsoncolumn
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"reflect"
+)
+
+// MergePatch applies an RFC 7396 JSON Merge Patch to the current value:
+// null members remove keys, other members override, objects merge recursively.
+func (j *JsonColumn[T]) MergePatch(patch []byte) error {
+	patchDoc, err := decodeJSONDocument(patch)
+	if err != nil {
+		return err
+	}
+	current, err := toJSONDocument(j.V)
+	if err != nil {
+		return err
+	}
+
+	merged, err := json.Marshal(applyMergePatch(current, patchDoc))
+	if err != nil {
+		return err
+	}
+	if string(merged) == "null" {
+		j.V = nil
+		return nil
+	}
+
+	v := new(T)
+	if err := json.Unmarshal(merged, v); err != nil {
+		return err
+	}
+	j.V = v
+	return nil
+}
+
+// Diff returns the merge patch that turns j into other, "{}" when both are equal.
+func (j *JsonColumn[T]) Diff(other *JsonColumn[T]) ([]byte, error) {
+	from, err := toJSONDocument(j.V)
+	if err != nil {
+		return nil, err
+	}
+	var otherValue *T
+	if other != nil {
+		otherValue = other.V
+	}
+	to, err := toJSONDocument(otherValue)
+	if err != nil {
+		return nil, err
+	}
+
+	if reflect.DeepEqual(from, to) {
+		return []byte("{}"), nil
+	}
+	return json.Marshal(createMergePatch(from, to))
+}
+
+func toJSONDocument(v interface{}) (interface{}, error) {
+	raw, err := json.Marshal(v)
+	if err != nil {
+		return nil, err
+	}
+	return decodeJSONDocument(raw)
+}
+
+// Numbers are kept as json.Number so values above 2^53 survive untouched.
+// Both sides of a comparison come from json.Marshal, so equal numbers have
+// the same text.
+func decodeJSONDocument(raw []byte) (interface{}, error) {
+	decoder := json.NewDecoder(bytes.NewReader(raw))
+	decoder.UseNumber()
+	var doc interface{}
+	if err := decoder.Decode(&doc); err != nil {
+		return nil, err
+	}
+	if decoder.More() {
+		return nil, errors.New("jsoncolumn: unexpected data after JSON document")
+	}
+	return doc, nil
+}
+
+func applyMergePatch(target, patch interface{}) interface{} {
+	patchObj, ok := patch.(map[string]interface{})
+	if !ok {
+		return patch
+	}
+
+	targetObj, ok := target.(map[string]interface{})
+	if !ok {
+		targetObj = map[string]interface{}{}
+	}
+	for key, value := range patchObj {
+		if value == nil {
+			delete(targetObj, key)
+			continue
+		}
+		targetObj[key] = applyMergePatch(targetObj[key], value)
+	}
+	return targetObj
+}
+
+func createMergePatch(from, to interface{}) interface{} {
+	fromObj, fromIsObj := from.(map[string]interface{})
+	toObj, toIsObj := to.(map[string]interface{})
+	if !fromIsObj || !toIsObj {
+		return to
+	}
+
+	patch := map[string]interface{}{}
+	for key := range fromObj {
+		if _, ok := toObj[key]; !ok {
+			patch[key] = nil
+		}
+	}
+	for key, toValue := range toObj {
+		fromValue, ok := fromObj[key]
+		if ok && reflect.DeepEqual(fromValue, toValue) {
+			continue
+		}
+		if ok {
+			patch[key] = createMergePatch(fromValue, toValue)
+		} else {
+			patch[key] = toValue
+		}
+	}
+	return patch
+}
@@ -0,0 +1,175 @@
+package jsoncolumn
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func newAnyColumn(t *testing.T, raw string) *JsonColumn[any] {
+	t.Helper()
+	col := &JsonColumn[any]{}
+	if err := col.Scan([]byte(raw)); err != nil {
+		t.Fatal(err)
+	}
+	return col
+}
+
+// Re-encodes through interface{} so key order and spacing don't matter.
+func canonicalJSON(t *testing.T, raw []byte) string {
+	t.Helper()
+	var v interface{}
+	if err := json.Unmarshal(raw, &v); err != nil {
+		t.Fatalf("invalid JSON %s: %v", raw, err)
+	}
+	out, err := json.Marshal(v)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return string(out)
+}
+
+// Test cases from RFC 7396 appendix A.
+func TestMergePatchRFC7396(t *testing.T) {
+	tests := []struct {
+		target, patch, want string
+	}{
+		{`{"a":"b"}`, `{"a":"c"}`, `{"a":"c"}`},
+		{`{"a":"b"}`, `{"b":"c"}`, `{"a":"b","b":"c"}`},
+		{`{"a":"b"}`, `{"a":null}`, `{}`},
+		{`{"a":"b","b":"c"}`, `{"a":null}`, `{"b":"c"}`},
+		{`{"a":["b"]}`, `{"a":"c"}`, `{"a":"c"}`},
+		{`{"a":"c"}`, `{"a":["b"]}`, `{"a":["b"]}`},
+		{`{"a":{"b":"c"}}`, `{"a":{"b":"d","c":null}}`, `{"a":{"b":"d"}}`},
+		{`{"a":[{"b":"c"}]}`, `{"a":[1]}`, `{"a":[1]}`},
+		{`["a","b"]`, `["c","d"]`, `["c","d"]`},
+		{`{"a":"b"}`, `["c"]`, `["c"]`},
+		{`{"a":"foo"}`, `null`, `null`},
+		{`{"a":"foo"}`, `"bar"`, `"bar"`},
+		{`{"e":null}`, `{"a":1}`, `{"e":null,"a":1}`},
+		{`[1,2]`, `{"a":"b","c":null}`, `{"a":"b"}`},
+		{`{}`, `{"a":{"bb":{"ccc":null}}}`, `{"a":{"bb":{}}}`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.target+" + "+tt.patch, func(t *testing.T) {
+			col := newAnyColumn(t, tt.target)
+			if err := col.MergePatch([]byte(tt.patch)); err != nil {
+				t.Fatal(err)
+			}
+			got, err := json.Marshal(col.V)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if canonicalJSON(t, got) != canonicalJSON(t, []byte(tt.want)) {
+				t.Fatalf("got %s, want %s", got, tt.want)
+			}
+		})
+	}
+}
+
+type patchDoc struct {
+	ID      int64             `json:"id"`
+	Name    string            `json:"name,omitempty"`
+	Tags    []string          `json:"tags,omitempty"`
+	Address *patchAddress     `json:"address,omitempty"`
+	Meta    map[string]string `json:"meta,omitempty"`
+}
+
+type patchAddress struct {
+	City    string `json:"city,omitempty"`
+	Zipcode string `json:"zipcode,omitempty"`
+}
+
+func TestMergePatchStruct(t *testing.T) {
+	col := JsonColumn[patchDoc]{V: &patchDoc{
+		ID:      1,
+		Name:    "a",
+		Address: &patchAddress{City: "Bangkok", Zipcode: "10110"},
+		Meta:    map[string]string{"source": "import", "batch": "7"},
+	}}
+
+	if err := col.MergePatch([]byte(`{"address":{"zipcode":"10200"},"meta":{"batch":null}}`)); err != nil {
+		t.Fatal(err)
+	}
+	if col.V.Address.City != "Bangkok" || col.V.Address.Zipcode != "10200" {
+		t.Fatalf("nested merge: address = %+v", col.V.Address)
+	}
+	if len(col.V.Meta) != 1 || col.V.Meta["source"] != "import" {
+		t.Fatalf("null removal: meta = %v", col.V.Meta)
+	}
+	if col.V.Name != "a" || col.V.ID != 1 {
+		t.Fatalf("untouched fields changed: %+v", col.V)
+	}
+}
+
+func TestMergePatchKeepsLargeIntegers(t *testing.T) {
+	const id = int64(1)<<53 + 1 // 9007199254740993, not representable as float64
+
+	col := JsonColumn[patchDoc]{V: &patchDoc{ID: id, Name: "a"}}
+	if err := col.MergePatch([]byte(`{"name":"b"}`)); err != nil {
+		t.Fatal(err)
+	}
+	if col.V.ID != id {
+		t.Fatalf("untouched ID = %d, want %d", col.V.ID, id)
+	}
+
+	if err := col.MergePatch([]byte(`{"id":9007199254740995}`)); err != nil {
+		t.Fatal(err)
+	}
+	if col.V.ID != id+2 {
+		t.Fatalf("patched ID = %d, want %d", col.V.ID, id+2)
+	}
+}
+
+func TestDiffLargeIntegers(t *testing.T) {
+	from := JsonColumn[patchDoc]{V: &patchDoc{ID: 9007199254740993}}
+	to := JsonColumn[patchDoc]{V: &patchDoc{ID: 9007199254740995}}
+
+	patch, err := from.Diff(&to)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(patch) != `{"id":9007199254740995}` {
+		t.Fatalf("Diff = %s", patch)
+	}
+}
+
+func TestDiffMergePatchRoundTrip(t *testing.T) {
+	tests := []struct {
+		name     string
+		from, to patchDoc
+	}{
+		{"equal", patchDoc{ID: 1, Name: "a"}, patchDoc{ID: 1, Name: "a"}},
+		{"changed field", patchDoc{ID: 1, Name: "a"}, patchDoc{ID: 1, Name: "b"}},
+		{"removed field", patchDoc{ID: 1, Name: "a"}, patchDoc{ID: 1}},
+		{"added nested", patchDoc{ID: 1}, patchDoc{ID: 1, Address: &patchAddress{City: "Chiang Mai"}}},
+		{"nested change", patchDoc{ID: 1, Address: &patchAddress{City: "Bangkok", Zipcode: "10110"}}, patchDoc{ID: 1, Address: &patchAddress{City: "Bangkok"}}},
+		{"array replaced", patchDoc{ID: 1, Tags: []string{"a", "b"}}, patchDoc{ID: 1, Tags: []string{"b"}}},
+		{"map keys", patchDoc{ID: 1, Meta: map[string]string{"x": "1", "y": "2"}}, patchDoc{ID: 1, Meta: map[string]string{"y": "3", "z": "4"}}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			from, to := tt.from, tt.to
+			src := JsonColumn[patchDoc]{V: &from}
+			dst := JsonColumn[patchDoc]{V: &to}
+
+			patch, err := src.Diff(&dst)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if tt.name == "equal" && string(patch) != "{}" {
+				t.Fatalf("Diff of equal values = %s, want {}", patch)
+			}
+			if err := src.MergePatch(patch); err != nil {
+				t.Fatal(err)
+			}
+
+			got, _ := json.Marshal(src.V)
+			want, _ := json.Marshal(dst.V)
+			if string(got) != string(want) {
+				t.Fatalf("after applying %s: got %s, want %s", patch, got, want)
+			}
+		})
+	}
+}
@@ -1,31 +1,76 @@
 package customvalidator
 
 import (
+	"bufio"
 	"context"
+	"encoding/json"
 	"fmt"
+	"net"
+	"net/http"
+	"net/url"
 	"reflect"
 	"regexp"
+	"strconv"
 	"strings"
+	"sync"
 	"time"
+	"unicode/utf8"
 
 	"github.com/go-playground/validator/v10"
+	"github.com/google/uuid"
+	iso8601date "github.com/jecitDev/jec-go-helper/pkg/ISO8601date"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
 	"google.golang.org/grpc/status"
 )
 
 type CustomValidator struct {
 	Validator *validator.Validate
+	locales   map[string]map[string]string
 }
 
-func NewCustomValidator() *CustomValidator {
+type Option func(*CustomValidator)
+
+// WithLocale adds or overrides the message map for a locale. Templates may use
+// {field} and {param} placeholders, tags missing from the map fall back to English.
+func WithLocale(locale string, messages map[string]string) Option {
+	return func(cv *CustomValidator) {
+		key := strings.ToLower(locale)
+		merged := make(map[string]string, len(messages))
+		for tag, tmpl := range cv.locales[key] {
+			merged[tag] = tmpl
+		}
+		for tag, tmpl := range messages {
+			merged[tag] = tmpl
+		}
+		cv.locales[key] = merged
+	}
+}
+
+func NewCustomValidator(opts ...Option) *CustomValidator {
 	valCustom := validator.New()
 
 	valCustom.RegisterCustomTypeFunc(validateTime, time.Time{})
 	valCustom.RegisterValidation("ISO8601date", validateDateTimeIso8601)
+	valCustom.RegisterValidation("ISO8601dateOrDate", validateDateTimeOrDateIso8601)
 	valCustom.RegisterValidation("daterange", validateDateRange)
+	valCustom.RegisterValidation("uuid4", validateUUID4)
+	valCustom.RegisterValidation("uuid7", validateUUID7)
+	valCustom.RegisterValidation("uuid_any", validateUUIDAny)
+	valCustom.RegisterValidation("e164phone", validateE164Phone)
+	registerStrictPhoneValidation(valCustom)
+	valCustom.RegisterValidation("active_url", validateActiveURL)
+	valCustom.RegisterValidation("min_len", validateMinLen)
+	valCustom.RegisterValidation("max_len", validateMaxLen)
+	valCustom.RegisterValidation("unique_items", validateUniqueItems)
+
+	cv := &CustomValidator{Validator: valCustom, locales: builtinLocales()}
+	for _, opt := range opts {
+		opt(cv)
+	}
 
-	return &CustomValidator{Validator: valCustom}
+	return cv
 }
 
 func (cv *CustomValidator) Validate(i interface{}) error {
@@ -43,15 +88,29 @@ func validateTime(field reflect.Value) interface{} {
 	return nil
 }
 
+var (
+	iso8601DateTimeRegex = regexp.MustCompile(`^(\d{4})-(\d{2})-(\d{2})T(\d{2}):(\d{2}):(\d{2})([+-])(\d{2}):(\d{2})$`)
+	iso8601DateOnlyRegex = regexp.MustCompile(`^(\d{4})-(\d{2})-(\d{2})$`)
+)
+
 func validateDateTimeIso8601(fl validator.FieldLevel) bool {
-	ISO8601DateRegexString := `^(\d{4})-(\d{2})-(\d{2})T(\d{2}):(\d{2}):(\d{2})([+-])(\d{2}):(\d{2})$`
-	ISO8601DateRegex := regexp.MustCompile(ISO8601DateRegexString)
+	return validateIso8601(fl, false)
+}
+
+// Like ISO8601date, but a plain YYYY-MM-DD date is accepted as well.
+func validateDateTimeOrDateIso8601(fl validator.FieldLevel) bool {
+	return validateIso8601(fl, true)
+}
 
+func validateIso8601(fl validator.FieldLevel, allowDateOnly bool) bool {
 	date := reflect.ValueOf(fl.Field()).Interface()
 	datestr := fmt.Sprintf("%v", date)
 
 	if len(datestr) > 0 {
-		return ISO8601DateRegex.MatchString(datestr)
+		if allowDateOnly && iso8601DateOnlyRegex.MatchString(datestr) {
+			return true
+		}
+		return iso8601DateTimeRegex.MatchString(datestr)
 	} else {
 
 		structField, found := fl.Parent().Type().FieldByName(fl.FieldName())
@@ -81,48 +140,319 @@ func containsRequiredTag(tag string) bool {
 	return false
 }
 
+// Used as `validate:"daterange=StartTime"` on the end field, it checks that
+// the annotated field is after the field named by the tag parameter.
 func validateDateRange(fl validator.FieldLevel) bool {
-	return fl.Field().String() == "daterange"
+	parent := fl.Parent()
+	if parent.Kind() == reflect.Ptr {
+		parent = parent.Elem()
+	}
+	if parent.Kind() != reflect.Struct {
+		return false
+	}
+
+	startField := parent.FieldByName(fl.Param())
+	endField := parent.FieldByName(fl.StructFieldName())
+	if !startField.IsValid() || !endField.IsValid() {
+		return false
+	}
+
+	start, ok := rangeFieldToTime(startField)
+	if !ok {
+		return false
+	}
+	end, ok := rangeFieldToTime(endField)
+	if !ok {
+		return false
+	}
+
+	return end.After(start)
+}
+
+func rangeFieldToTime(field reflect.Value) (time.Time, bool) {
+	if field.Kind() == reflect.Ptr {
+		if field.IsNil() {
+			return time.Time{}, false
+		}
+		field = field.Elem()
+	}
+
+	switch v := field.Interface().(type) {
+	case time.Time:
+		return v, true
+	case iso8601date.ISO8601date:
+		t, err := v.ToTime()
+		if err != nil {
+			return time.Time{}, false
+		}
+		return t, true
+	}
+	return time.Time{}, false
+}
+
+func validateUUID4(fl validator.FieldLevel) bool {
+	return isUUIDVersion(fl.Field().String(), 4)
+}
+
+func validateUUID7(fl validator.FieldLevel) bool {
+	return isUUIDVersion(fl.Field().String(), 7)
+}
+
+func validateUUIDAny(fl validator.FieldLevel) bool {
+	_, ok := parseCanonicalUUID(fl.Field().String())
+	return ok
+}
+
+func isUUIDVersion(s string, version uuid.Version) bool {
+	id, ok := parseCanonicalUUID(s)
+	return ok && id.Version() == version
+}
+
+// uuid.Parse also accepts braces, the urn:uuid: prefix and undashed hex, so
+// the canonical 8-4-4-4-12 form is checked before parsing.
+func parseCanonicalUUID(s string) (uuid.UUID, bool) {
+	if len(s) != 36 || s[8] != '-' || s[13] != '-' || s[18] != '-' || s[23] != '-' {
+		return uuid.UUID{}, false
+	}
+	id, err := uuid.Parse(s)
+	if err != nil {
+		return uuid.UUID{}, false
+	}
+	return id, true
+}
+
+// E.164: "+" then a country code starting with 1-9, 8 to 15 digits in total.
+var e164PhoneRegex = regexp.MustCompile(`^\+[1-9]\d{7,14}$`)
+
+func validateE164Phone(fl validator.FieldLevel) bool {
+	return e164PhoneRegex.MatchString(fl.Field().String())
+}
+
+func validateMinLen(fl validator.FieldLevel) bool {
+	length, limit, ok := fieldLenAndParam(fl)
+	return ok && length >= limit
+}
+
+func validateMaxLen(fl validator.FieldLevel) bool {
+	length, limit, ok := fieldLenAndParam(fl)
+	return ok && length <= limit
+}
+
+// Strings are measured in runes, slices, arrays and maps by element count.
+func fieldLenAndParam(fl validator.FieldLevel) (length int, limit int, ok bool) {
+	limit, err := strconv.Atoi(fl.Param())
+	if err != nil {
+		return 0, 0, false
+	}
+
+	field := fl.Field()
+	switch field.Kind() {
+	case reflect.String:
+		return utf8.RuneCountInString(field.String()), limit, true
+	case reflect.Slice, reflect.Array, reflect.Map:
+		return field.Len(), limit, true
+	}
+	return 0, 0, false
+}
+
+func validateUniqueItems(fl validator.FieldLevel) bool {
+	field := fl.Field()
+	if field.Kind() != reflect.Slice && field.Kind() != reflect.Array {
+		return false
+	}
+
+	for i := 0; i < field.Len(); i++ {
+		for j := i + 1; j < field.Len(); j++ {
+			if reflect.DeepEqual(field.Index(i).Interface(), field.Index(j).Interface()) {
+				return false
+			}
+		}
+	}
+	return true
 }
 
+// Only absolute http and https URLs with a host pass; used by active_url.
+func isHTTPURL(s string) bool {
+	u, err := url.Parse(s)
+	if err != nil {
+		return false
+	}
+	if u.Scheme != "http" && u.Scheme != "https" {
+		return false
+	}
+	return u.Host != ""
+}
+
+// GrpcErrorHandler converts validator errors into an InvalidArgument status
+// using the English message tokens. The "accept-language" metadata header is
+// ignored; use the CustomValidator method for localized messages.
 func GrpcErrorHandler() grpc.UnaryServerInterceptor {
+	return grpcErrorHandler(englishLocales())
+}
+
+// GrpcErrorHandler converts validator errors into an InvalidArgument status,
+// picking the message map from the "accept-language" metadata header.
+func (cv *CustomValidator) GrpcErrorHandler() grpc.UnaryServerInterceptor {
+	return grpcErrorHandler(cv.locales)
+}
+
+func grpcErrorHandler(locales map[string]map[string]string) grpc.UnaryServerInterceptor {
 	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
 		resp, err := handler(ctx, req)
-
-		var message []string
 		if err == nil {
 			return resp, err
 		}
-		if castedObject, ok := err.(validator.ValidationErrors); ok {
-			for _, err := range castedObject {
-				switch err.Tag() {
-				case "required":
-					message = append(message, fmt.Sprintf("validation_request|required|%s",
-						err.Field()))
-				case "email":
-					message = append(message, fmt.Sprintf("validation_request|not_email|%s",
-						err.Field()))
-				case "gte":
-					message = append(message, fmt.Sprintf("validation_request|gte|%s|%s",
-						err.Field(), err.Param()))
-				case "lte":
-					message = append(message, fmt.Sprintf("validation_request|lte|%s|%s",
-						err.Field(), err.Param()))
-				case "ISO8601date":
-					message = append(message, fmt.Sprintf("validation_request|not_iso8601date|%s",
-						err.Field()))
-				case "uuid4_rfc4122":
-					message = append(
-						message,
-						fmt.Sprintf("validation_request|not_uuid4|%s", err.Field()),
-					)
-				}
+
+		locale := ""
+		if md, ok := metadata.FromIncomingContext(ctx); ok {
+			if values := md.Get("accept-language"); len(values) > 0 {
+				locale = values[0]
 			}
 		}
-		if len(message) > 0 {
-			err = status.Errorf(codes.InvalidArgument, "%+v", message)
+
+		if castedObject, ok := err.(validator.ValidationErrors); ok {
+			message := formatValidationErrors(castedObject, locales, locale)
+			if len(message) > 0 {
+				err = status.Errorf(codes.InvalidArgument, "%+v", message)
+			}
 		}
 
 		return resp, err
 	}
 }
+
+// ValidationErrorToString formats validator errors with the built-in message
+// maps, for callers outside of gRPC.
+func ValidationErrorToString(err error, locale string) string {
+	return validationErrorToString(err, builtinLocales(), locale)
+}
+
+// ValidationErrorToString formats validator errors with the message maps
+// configured on this validator.
+func (cv *CustomValidator) ValidationErrorToString(err error, locale string) string {
+	return validationErrorToString(err, cv.locales, locale)
+}
+
+func validationErrorToString(err error, locales map[string]map[string]string, locale string) string {
+	if err == nil {
+		return ""
+	}
+	castedObject, ok := err.(validator.ValidationErrors)
+	if !ok {
+		return err.Error()
+	}
+	return fmt.Sprintf("%+v", formatValidationErrors(castedObject, locales, locale))
+}
+
+type validationErrorKey struct{}
+
+type validationErrorHolder struct {
+	err error
+}
+
+type statusRecorder struct {
+	http.ResponseWriter
+	wroteHeader bool
+}
+
+func (r *statusRecorder) WriteHeader(code int) {
+	r.wroteHeader = true
+	r.ResponseWriter.WriteHeader(code)
+}
+
+func (r *statusRecorder) Write(b []byte) (int, error) {
+	r.wroteHeader = true
+	return r.ResponseWriter.Write(b)
+}
+
+// Flush and Hijack are forwarded so SSE and websocket handlers keep working
+// behind the middleware.
+func (r *statusRecorder) Flush() {
+	if f, ok := r.ResponseWriter.(http.Flusher); ok {
+		r.wroteHeader = true
+		f.Flush()
+	}
+}
+
+func (r *statusRecorder) Hijack() (net.Conn, *bufio.ReadWriter, error) {
+	h, ok := r.ResponseWriter.(http.Hijacker)
+	if !ok {
+		return nil, nil, fmt.Errorf("customvalidator: %T does not implement http.Hijacker", r.ResponseWriter)
+	}
+	r.wroteHeader = true
+	return h.Hijack()
+}
+
+// Unwrap lets http.ResponseController reach the underlying writer.
+func (r *statusRecorder) Unwrap() http.ResponseWriter {
+	return r.ResponseWriter
+}
+
+// ReportValidationError passes a validation error from an HTTP handler to
+// HTTPValidationMiddleware. The handler must return without writing a response.
+func ReportValidationError(r *http.Request, err error) {
+	if holder, ok := r.Context().Value(validationErrorKey{}).(*validationErrorHolder); ok {
+		holder.err = err
+	}
+}
+
+// HTTPValidationMiddleware is the net/http counterpart of GrpcErrorHandler. Errors
+// passed to ReportValidationError are written as a 422 {"errors": [...]} body,
+// using the locale from the Accept-Language header. Any other reported error
+// becomes a 400 {"errors": ["<err>"]} response.
+func HTTPValidationMiddleware() func(http.Handler) http.Handler {
+	return httpValidationMiddleware(builtinLocales())
+}
+
+// HTTPValidationMiddleware is like the package level HTTPValidationMiddleware but
+// uses the message maps configured on this validator.
+func (cv *CustomValidator) HTTPValidationMiddleware() func(http.Handler) http.Handler {
+	return httpValidationMiddleware(cv.locales)
+}
+
+func httpValidationMiddleware(locales map[string]map[string]string) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			holder := &validationErrorHolder{}
+			recorder := &statusRecorder{ResponseWriter: w}
+			next.ServeHTTP(recorder, r.WithContext(context.WithValue(r.Context(), validationErrorKey{}, holder)))
+
+			if holder.err == nil || recorder.wroteHeader {
+				return
+			}
+
+			code := http.StatusUnprocessableEntity
+			var message []string
+			if castedObject, ok := holder.err.(validator.ValidationErrors); ok {
+				message = formatValidationErrors(castedObject, locales, r.Header.Get("Accept-Language"))
+			} else {
+				code = http.StatusBadRequest
+				message = []string{holder.err.Error()}
+			}
+			if message == nil {
+				message = []string{}
+			}
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(code)
+			json.NewEncoder(w).Encode(map[string][]string{"errors": message})
+		})
+	}
+}
+
+var (
+	defaultValidator     *CustomValidator
+	defaultValidatorOnce sync.Once
+)
+
+// ValidateHTTPRequest decodes the JSON request body into dst and validates it.
+// Validation failures are returned as validator.ValidationErrors.
+func ValidateHTTPRequest[T any](r *http.Request, dst *T) error {
+	if err := json.NewDecoder(r.Body).Decode(dst); err != nil {
+		return err
+	}
+
+	defaultValidatorOnce.Do(func() {
+		defaultValidator = NewCustomValidator()
+	})
+	return defaultValidator.Validate(dst)
+}
@@ -0,0 +1,337 @@
+package customvalidator
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/go-playground/validator/v10"
+	iso8601date "github.com/jecitDev/jec-go-helper/pkg/ISO8601date"
+)
+
+func TestUUIDTags(t *testing.T) {
+	type uuid4Input struct {
+		ID string `validate:"uuid4"`
+	}
+	type uuid7Input struct {
+		ID string `validate:"uuid7"`
+	}
+	type uuidAnyInput struct {
+		ID string `validate:"uuid_any"`
+	}
+
+	const (
+		v4 = "f47ac10b-58cc-4372-a567-0e02b2c3d479"
+		v7 = "018f3f6e-8a1c-7b3d-9c4e-5f6a7b8c9d0e"
+	)
+
+	cv := NewCustomValidator()
+	tests := []struct {
+		name  string
+		input interface{}
+		valid bool
+	}{
+		{"uuid4 valid", uuid4Input{ID: v4}, true},
+		{"uuid4 uppercase", uuid4Input{ID: "F47AC10B-58CC-4372-A567-0E02B2C3D479"}, true},
+		{"uuid4 nil uuid", uuid4Input{ID: "00000000-0000-0000-0000-000000000000"}, false},
+		{"uuid4 wrong version", uuid4Input{ID: v7}, false},
+		{"uuid4 braces", uuid4Input{ID: "{" + v4 + "}"}, false},
+		{"uuid4 urn prefix", uuid4Input{ID: "urn:uuid:" + v4}, false},
+		{"uuid4 no dashes", uuid4Input{ID: "f47ac10b58cc4372a5670e02b2c3d479"}, false},
+		{"uuid4 empty", uuid4Input{ID: ""}, false},
+		{"uuid7 valid", uuid7Input{ID: v7}, true},
+		{"uuid7 uppercase", uuid7Input{ID: "018F3F6E-8A1C-7B3D-9C4E-5F6A7B8C9D0E"}, true},
+		{"uuid7 nil uuid", uuid7Input{ID: "00000000-0000-0000-0000-000000000000"}, false},
+		{"uuid7 wrong version", uuid7Input{ID: v4}, false},
+		{"uuid_any v4", uuidAnyInput{ID: v4}, true},
+		{"uuid_any v7", uuidAnyInput{ID: v7}, true},
+		{"uuid_any uppercase", uuidAnyInput{ID: "F47AC10B-58CC-4372-A567-0E02B2C3D479"}, true},
+		{"uuid_any nil uuid", uuidAnyInput{ID: "00000000-0000-0000-0000-000000000000"}, true},
+		{"uuid_any braces", uuidAnyInput{ID: "{" + v4 + "}"}, false},
+		{"uuid_any garbage", uuidAnyInput{ID: "not-a-uuid"}, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := cv.Validate(tt.input)
+			if tt.valid && err != nil {
+				t.Fatalf("expected valid, got %v", err)
+			}
+			if !tt.valid && err == nil {
+				t.Fatal("expected a validation error")
+			}
+		})
+	}
+}
+
+func TestUUIDErrorMessage(t *testing.T) {
+	type input struct {
+		ID string `validate:"uuid4"`
+	}
+
+	cv := NewCustomValidator()
+	got := cv.ValidationErrorToString(cv.Validate(input{ID: "nope"}), "")
+	if want := "[validation_request|not_uuid4|ID]"; got != want {
+		t.Fatalf("got %q, want %q", got, want)
+	}
+}
+
+func TestE164Phone(t *testing.T) {
+	type input struct {
+		Phone string `validate:"e164phone"`
+	}
+
+	cv := NewCustomValidator()
+	tests := []struct {
+		name  string
+		phone string
+		valid bool
+	}{
+		{"US", "+14155552671", true},
+		{"Thai mobile", "+66812345678", true},
+		{"Thai landline", "+6621234567", true},
+		{"UK", "+442071838750", true},
+		{"Japan", "+81312345678", true},
+		{"Singapore", "+6591234567", true},
+		{"15 digits", "+123456789012345", true},
+		{"missing plus", "14155552671", false},
+		{"leading zero country code", "+0812345678", false},
+		{"too short", "+1234567", false},
+		{"too long", "+1234567890123456", false},
+		{"spaces", "+1 415 555 2671", false},
+		{"dashes", "+66-81-234-5678", false},
+		{"empty", "", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := cv.Validate(input{Phone: tt.phone})
+			if tt.valid && err != nil {
+				t.Fatalf("expected %q to be valid, got %v", tt.phone, err)
+			}
+			if !tt.valid && err == nil {
+				t.Fatalf("expected %q to be invalid", tt.phone)
+			}
+		})
+	}
+}
+
+func TestURLTags(t *testing.T) {
+	type urlInput struct {
+		Link string `validate:"url"`
+	}
+	type uriInput struct {
+		Link string `validate:"uri"`
+	}
+	type httpURLInput struct {
+		Link string `validate:"http_url"`
+	}
+
+	cv := NewCustomValidator()
+	tests := []struct {
+		name  string
+		input interface{}
+		valid bool
+	}{
+		{"url keeps built-in ftp support", urlInput{Link: "ftp://files.example.com/a"}, true},
+		{"uri keeps built-in relative paths", uriInput{Link: "/relative/path"}, true},
+		{"http_url https", httpURLInput{Link: "https://example.com/hook"}, true},
+		{"http_url http with port", httpURLInput{Link: "http://example.com:8080"}, true},
+		{"http_url rejects ftp", httpURLInput{Link: "ftp://files.example.com/a"}, false},
+		{"http_url rejects relative", httpURLInput{Link: "/relative/path"}, false},
+		{"http_url rejects missing host", httpURLInput{Link: "https://"}, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := cv.Validate(tt.input)
+			if tt.valid && err != nil {
+				t.Fatalf("expected valid, got %v", err)
+			}
+			if !tt.valid && err == nil {
+				t.Fatal("expected a validation error")
+			}
+		})
+	}
+}
+
+func TestRequiredIfUsesBuiltinWithMessages(t *testing.T) {
+	type input struct {
+		Type   string
+		Doctor string `validate:"required_if=Type appointment"`
+		Reason string `validate:"required_unless=Type walkin"`
+	}
+
+	cv := NewCustomValidator()
+	if err := cv.Validate(input{Type: "walkin"}); err != nil {
+		t.Fatalf("expected valid, got %v", err)
+	}
+
+	got := cv.ValidationErrorToString(cv.Validate(input{Type: "appointment"}), "")
+	want := "[validation_request|required_if|Doctor|Type appointment validation_request|required_unless|Reason|Type walkin]"
+	if got != want {
+		t.Fatalf("got %q, want %q", got, want)
+	}
+}
+
+func TestHTTPValidationMiddleware(t *testing.T) {
+	type input struct {
+		Name string `validate:"required"`
+	}
+
+	tests := []struct {
+		name     string
+		body     string
+		wantCode int
+		wantBody string
+	}{
+		{"valid", `{"name":"x"}`, http.StatusOK, ""},
+		{"validation error", `{}`, http.StatusUnprocessableEntity, `{"errors":["validation_request|required|Name"]}` + "\n"},
+		{"decode error", `{`, http.StatusBadRequest, `{"errors":["unexpected EOF"]}` + "\n"},
+	}
+
+	handler := HTTPValidationMiddleware()(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var in input
+		if err := ValidateHTTPRequest(r, &in); err != nil {
+			ReportValidationError(r, err)
+			return
+		}
+	}))
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rec := httptest.NewRecorder()
+			handler.ServeHTTP(rec, httptest.NewRequest(http.MethodPost, "/", strings.NewReader(tt.body)))
+			if rec.Code != tt.wantCode {
+				t.Fatalf("code = %d, want %d", rec.Code, tt.wantCode)
+			}
+			if got := rec.Body.String(); got != tt.wantBody {
+				t.Fatalf("body = %q, want %q", got, tt.wantBody)
+			}
+		})
+	}
+}
+
+func TestHTTPValidationMiddlewareForwardsFlusher(t *testing.T) {
+	var flusher, controllerFlush bool
+	handler := HTTPValidationMiddleware()(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, flusher = w.(http.Flusher)
+		controllerFlush = http.NewResponseController(w).Flush() == nil
+	}))
+
+	handler.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(http.MethodGet, "/", nil))
+	if !flusher {
+		t.Fatal("wrapped writer does not implement http.Flusher")
+	}
+	if !controllerFlush {
+		t.Fatal("http.ResponseController could not flush")
+	}
+}
+
+func TestLengthAndUniqueTags(t *testing.T) {
+	type stringSlice struct {
+		Tags []string `validate:"min_len=1,max_len=3,unique_items"`
+	}
+	type intSlice struct {
+		IDs []int `validate:"min_len=2,max_len=4,unique_items"`
+	}
+	type stringMap struct {
+		Labels map[string]string `validate:"min_len=1,max_len=2"`
+	}
+	type text struct {
+		Name string `validate:"min_len=2,max_len=4"`
+	}
+
+	cv := NewCustomValidator()
+	tests := []struct {
+		name    string
+		input   interface{}
+		wantTag string
+	}{
+		{"[]string valid", stringSlice{Tags: []string{"a", "b"}}, ""},
+		{"[]string too short", stringSlice{Tags: []string{}}, "min_len"},
+		{"[]string nil", stringSlice{}, "min_len"},
+		{"[]string too long", stringSlice{Tags: []string{"a", "b", "c", "d"}}, "max_len"},
+		{"[]string duplicate", stringSlice{Tags: []string{"a", "a"}}, "unique_items"},
+		{"[]int valid", intSlice{IDs: []int{1, 2, 3}}, ""},
+		{"[]int too short", intSlice{IDs: []int{1}}, "min_len"},
+		{"[]int too long", intSlice{IDs: []int{1, 2, 3, 4, 5}}, "max_len"},
+		{"[]int duplicate", intSlice{IDs: []int{1, 2, 1}}, "unique_items"},
+		{"map valid", stringMap{Labels: map[string]string{"a": "1"}}, ""},
+		{"map too short", stringMap{Labels: map[string]string{}}, "min_len"},
+		{"map too long", stringMap{Labels: map[string]string{"a": "1", "b": "2", "c": "3"}}, "max_len"},
+		{"string counts runes", text{Name: "สวัส"}, ""},
+		{"string too long", text{Name: "abcde"}, "max_len"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := cv.Validate(tt.input)
+			if tt.wantTag == "" {
+				if err != nil {
+					t.Fatalf("expected valid, got %v", err)
+				}
+				return
+			}
+			errs, ok := err.(validator.ValidationErrors)
+			if !ok || len(errs) != 1 {
+				t.Fatalf("expected one validation error, got %v", err)
+			}
+			if errs[0].Tag() != tt.wantTag {
+				t.Fatalf("tag = %q, want %q", errs[0].Tag(), tt.wantTag)
+			}
+		})
+	}
+}
+
+func TestLengthErrorMessage(t *testing.T) {
+	type input struct {
+		Tags []string `validate:"min_len=2"`
+	}
+
+	cv := NewCustomValidator()
+	got := cv.ValidationErrorToString(cv.Validate(input{Tags: []string{"a"}}), "")
+	if want := "[validation_request|min_len|Tags|2]"; got != want {
+		t.Fatalf("got %q, want %q", got, want)
+	}
+}
+
+func TestISO8601DateOnlyNeedsOptIn(t *testing.T) {
+	type strict struct {
+		Date iso8601date.ISO8601date `validate:"ISO8601date"`
+	}
+	type lenient struct {
+		Date iso8601date.ISO8601date `validate:"ISO8601dateOrDate"`
+	}
+
+	cv := NewCustomValidator()
+	tests := []struct {
+		name  string
+		body  string
+		dst   interface{}
+		valid bool
+	}{
+		{"strict datetime", `{"Date":"2024-01-01T10:00:00+07:00"}`, &strict{}, true},
+		{"strict date only", `{"Date":"2024-01-01"}`, &strict{}, false},
+		{"lenient datetime", `{"Date":"2024-01-01T10:00:00+07:00"}`, &lenient{}, true},
+		{"lenient date only", `{"Date":"2024-01-01"}`, &lenient{}, true},
+		{"lenient garbage", `{"Date":"01/01/2024"}`, &lenient{}, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := json.Unmarshal([]byte(tt.body), tt.dst); err != nil {
+				t.Fatal(err)
+			}
+			err := cv.Validate(tt.dst)
+			if tt.valid && err != nil {
+				t.Fatalf("expected valid, got %v", err)
+			}
+			if !tt.valid && err == nil {
+				t.Fatal("expected a validation error")
+			}
+		})
+	}
+}
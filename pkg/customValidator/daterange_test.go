@@ -0,0 +1,100 @@
+package customvalidator
+
+import (
+	"testing"
+	"time"
+
+	"github.com/go-playground/validator/v10"
+	iso8601date "github.com/jecitDev/jec-go-helper/pkg/ISO8601date"
+)
+
+func TestDateRange(t *testing.T) {
+	type timeRange struct {
+		StartTime time.Time
+		EndTime   time.Time `validate:"daterange=StartTime"`
+	}
+	type pointerRange struct {
+		StartTime *time.Time
+		EndTime   *time.Time `validate:"daterange=StartTime"`
+	}
+	type isoRange struct {
+		StartTime iso8601date.ISO8601date
+		EndTime   iso8601date.ISO8601date `validate:"daterange=StartTime"`
+	}
+	type missingParam struct {
+		EndTime time.Time `validate:"daterange=StartTime"`
+	}
+
+	start := time.Date(2024, 1, 1, 9, 0, 0, 0, time.UTC)
+	later := start.Add(time.Hour)
+	earlier := start.Add(-time.Hour)
+
+	cv := NewCustomValidator()
+	tests := []struct {
+		name  string
+		input interface{}
+		valid bool
+	}{
+		{"end after start", timeRange{start, later}, true},
+		{"end equal to start", timeRange{start, start}, false},
+		{"end before start", timeRange{start, earlier}, false},
+		{"zero end", timeRange{StartTime: start}, false},
+		{"zero start and end", timeRange{}, false},
+		{"pointers end after start", pointerRange{&start, &later}, true},
+		{"pointers end before start", pointerRange{&start, &earlier}, false},
+		{"nil start pointer", pointerRange{EndTime: &later}, false},
+		{"nil end pointer", pointerRange{StartTime: &start}, false},
+		{"nil pointers", pointerRange{}, false},
+		{
+			"iso8601 later instant, earlier wall clock",
+			isoRange{iso8601date.MustParse("2024-01-01T10:00:00+07:00"), iso8601date.MustParse("2024-01-01T04:00:00+00:00")},
+			true,
+		},
+		{
+			"iso8601 same instant, different offsets",
+			isoRange{iso8601date.MustParse("2024-01-01T10:00:00+07:00"), iso8601date.MustParse("2024-01-01T03:00:00+00:00")},
+			false,
+		},
+		{
+			"iso8601 earlier instant, later wall clock",
+			isoRange{iso8601date.MustParse("2024-01-01T10:00:00+07:00"), iso8601date.MustParse("2024-01-01T12:00:00+10:00")},
+			false,
+		},
+		{"iso8601 zero values", isoRange{}, false},
+		{"missing param field", missingParam{EndTime: later}, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := cv.Validate(tt.input)
+			if tt.valid && err != nil {
+				t.Fatalf("expected valid, got %v", err)
+			}
+			if tt.valid {
+				return
+			}
+			errs, ok := err.(validator.ValidationErrors)
+			if !ok || len(errs) != 1 || errs[0].Tag() != "daterange" {
+				t.Fatalf("err = %v, want one daterange error", err)
+			}
+		})
+	}
+}
+
+func TestDateRangeErrorMessage(t *testing.T) {
+	type input struct {
+		StartTime time.Time
+		EndTime   time.Time `validate:"daterange=StartTime"`
+	}
+
+	start := time.Date(2024, 1, 1, 9, 0, 0, 0, time.UTC)
+	cv := NewCustomValidator()
+	err := cv.Validate(input{StartTime: start, EndTime: start.Add(-time.Minute)})
+
+	if got, want := cv.ValidationErrorToString(err, ""), "[validation_request|invalid_daterange|EndTime]"; got != want {
+		t.Fatalf("got %q, want %q", got, want)
+	}
+	if got, want := cv.ValidationErrorToString(err, "th"), "[EndTime ต้องอยู่หลังวันที่เริ่มต้น]"; got != want {
+		t.Fatalf("th: got %q, want %q", got, want)
+	}
+}
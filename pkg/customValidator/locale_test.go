@@ -0,0 +1,116 @@
+package customvalidator
+
+import (
+	"context"
+	"testing"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+type localeInput struct {
+	Name string `validate:"required"`
+}
+
+func TestResolveLocaleFallback(t *testing.T) {
+	locales := builtinLocales()
+	locales["th-th"] = map[string]string{"required": "th-TH {field}"}
+
+	tests := []struct {
+		acceptLanguage string
+		want           string
+	}{
+		{"th-TH", "th-TH {field}"},
+		{"th-TH,th;q=0.9", "th-TH {field}"},
+		{"th-CH", thaiMessages["required"]},
+		{"th_TH", thaiMessages["required"]},
+		{"TH", thaiMessages["required"]},
+		{"fr-FR, th;q=0.5", thaiMessages["required"]},
+		{"fr-FR", englishMessages["required"]},
+		{"", englishMessages["required"]},
+		{" , ;q=1", englishMessages["required"]},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.acceptLanguage, func(t *testing.T) {
+			if got := resolveLocale(locales, tt.acceptLanguage)["required"]; got != tt.want {
+				t.Fatalf("resolveLocale(%q) = %q, want %q", tt.acceptLanguage, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestWithLocale(t *testing.T) {
+	cv := NewCustomValidator(
+		WithLocale("TH", map[string]string{"required": "ต้องระบุ {field}"}),
+		WithLocale("de", map[string]string{"required": "{field} ist erforderlich"}),
+	)
+	err := cv.Validate(localeInput{})
+
+	if got, want := cv.ValidationErrorToString(err, "th"), "[ต้องระบุ Name]"; got != want {
+		t.Errorf("overridden tag: got %q, want %q", got, want)
+	}
+	if got, want := cv.ValidationErrorToString(err, "de-DE"), "[Name ist erforderlich]"; got != want {
+		t.Errorf("new locale: got %q, want %q", got, want)
+	}
+	if got, want := cv.locales["th"]["email"], thaiMessages["email"]; got != want {
+		t.Errorf("untouched Thai tag = %q, want %q", got, want)
+	}
+
+	type emailInput struct {
+		Email string `validate:"email"`
+	}
+	got := cv.ValidationErrorToString(cv.Validate(emailInput{Email: "x"}), "de")
+	if want := "[validation_request|not_email|Email]"; got != want {
+		t.Errorf("missing tag should fall back to English: got %q, want %q", got, want)
+	}
+
+	if NewCustomValidator().locales["th"]["required"] != thaiMessages["required"] {
+		t.Error("WithLocale leaked into the built-in message maps")
+	}
+}
+
+func runGrpcHandler(t *testing.T, interceptor grpc.UnaryServerInterceptor, acceptLanguage string) string {
+	t.Helper()
+	cv := NewCustomValidator()
+	ctx := context.Background()
+	if acceptLanguage != "" {
+		ctx = metadata.NewIncomingContext(ctx, metadata.Pairs("accept-language", acceptLanguage))
+	}
+
+	_, err := interceptor(ctx, nil, &grpc.UnaryServerInfo{}, func(ctx context.Context, req interface{}) (interface{}, error) {
+		return nil, cv.Validate(localeInput{})
+	})
+	st, ok := status.FromError(err)
+	if !ok {
+		t.Fatalf("err = %v, want a gRPC status", err)
+	}
+	return st.Message()
+}
+
+func TestGrpcErrorHandlerLocale(t *testing.T) {
+	english := "[validation_request|required|Name]"
+	thai := "[Name จำเป็นต้องระบุ]"
+
+	tests := []struct {
+		name           string
+		interceptor    grpc.UnaryServerInterceptor
+		acceptLanguage string
+		want           string
+	}{
+		{"package level ignores metadata", GrpcErrorHandler(), "th-TH", english},
+		{"package level without metadata", GrpcErrorHandler(), "", english},
+		{"method reads metadata", NewCustomValidator().GrpcErrorHandler(), "th-TH,th;q=0.9", thai},
+		{"method without metadata", NewCustomValidator().GrpcErrorHandler(), "", english},
+		{"method unknown locale", NewCustomValidator().GrpcErrorHandler(), "fr", english},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := runGrpcHandler(t, tt.interceptor, tt.acceptLanguage); got != tt.want {
+				t.Fatalf("message = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
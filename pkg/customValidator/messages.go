@@ -0,0 +1,118 @@
+package customvalidator
+
+import (
+	"strings"
+
+	"github.com/go-playground/validator/v10"
+)
+
+const defaultLocale = "en"
+
+var englishMessages = map[string]string{
+	"required":          "validation_request|required|{field}",
+	"email":             "validation_request|not_email|{field}",
+	"gte":               "validation_request|gte|{field}|{param}",
+	"lte":               "validation_request|lte|{field}|{param}",
+	"ISO8601date":       "validation_request|not_iso8601date|{field}",
+	"ISO8601dateOrDate": "validation_request|not_iso8601date|{field}",
+	"uuid4_rfc4122":     "validation_request|not_uuid4|{field}",
+	"uuid4":             "validation_request|not_uuid4|{field}",
+	"uuid7":             "validation_request|not_uuid7|{field}",
+	"uuid_any":          "validation_request|not_uuid|{field}",
+	"e164phone":         "validation_request|not_e164phone|{field}",
+	"e164phone_strict":  "validation_request|not_e164phone|{field}",
+	"url":               "validation_request|not_url|{field}",
+	"uri":               "validation_request|not_uri|{field}",
+	"http_url":          "validation_request|not_http_url|{field}",
+	"active_url":        "validation_request|not_active_url|{field}",
+	"required_if":       "validation_request|required_if|{field}|{param}",
+	"required_unless":   "validation_request|required_unless|{field}|{param}",
+	"daterange":         "validation_request|invalid_daterange|{field}",
+	"min_len":           "validation_request|min_len|{field}|{param}",
+	"max_len":           "validation_request|max_len|{field}|{param}",
+	"unique_items":      "validation_request|unique_items|{field}",
+}
+
+var thaiMessages = map[string]string{
+	"required":          "{field} จำเป็นต้องระบุ",
+	"email":             "{field} ต้องเป็นอีเมลที่ถูกต้อง",
+	"gte":               "{field} ต้องมากกว่าหรือเท่ากับ {param}",
+	"lte":               "{field} ต้องน้อยกว่าหรือเท่ากับ {param}",
+	"ISO8601date":       "{field} ต้องเป็นวันที่รูปแบบ ISO8601",
+	"ISO8601dateOrDate": "{field} ต้องเป็นวันที่รูปแบบ ISO8601",
+	"uuid4_rfc4122":     "{field} ต้องเป็น UUID เวอร์ชัน 4",
+	"uuid4":             "{field} ต้องเป็น UUID เวอร์ชัน 4",
+	"uuid7":             "{field} ต้องเป็น UUID เวอร์ชัน 7",
+	"uuid_any":          "{field} ต้องเป็น UUID",
+	"e164phone":         "{field} ต้องเป็นหมายเลขโทรศัพท์รูปแบบ E.164",
+	"e164phone_strict":  "{field} ต้องเป็นหมายเลขโทรศัพท์รูปแบบ E.164",
+	"url":               "{field} ต้องเป็น URL ที่ถูกต้อง",
+	"uri":               "{field} ต้องเป็น URI ที่ถูกต้อง",
+	"http_url":          "{field} ต้องเป็น URL แบบ http หรือ https",
+	"active_url":        "{field} ต้องเป็น URL ที่เข้าถึงได้",
+	"required_if":       "{field} จำเป็นต้องระบุเมื่อ {param}",
+	"required_unless":   "{field} จำเป็นต้องระบุ ยกเว้นเมื่อ {param}",
+	"daterange":         "{field} ต้องอยู่หลังวันที่เริ่มต้น",
+	"min_len":           "{field} ต้องมีความยาวอย่างน้อย {param}",
+	"max_len":           "{field} ต้องมีความยาวไม่เกิน {param}",
+	"unique_items":      "{field} ต้องไม่มีรายการซ้ำ",
+}
+
+func builtinLocales() map[string]map[string]string {
+	return map[string]map[string]string{
+		defaultLocale: copyMessages(englishMessages),
+		"th":          copyMessages(thaiMessages),
+	}
+}
+
+// Only the English tokens, so any requested locale resolves to them.
+func englishLocales() map[string]map[string]string {
+	return map[string]map[string]string{defaultLocale: copyMessages(englishMessages)}
+}
+
+func copyMessages(messages map[string]string) map[string]string {
+	copied := make(map[string]string, len(messages))
+	for tag, tmpl := range messages {
+		copied[tag] = tmpl
+	}
+	return copied
+}
+
+// Picks the first locale from an Accept-Language style value ("th-TH,th;q=0.9")
+// that has a message map, trying the full tag before the primary subtag.
+func resolveLocale(locales map[string]map[string]string, acceptLanguage string) map[string]string {
+	for _, part := range strings.Split(acceptLanguage, ",") {
+		tag := strings.ToLower(strings.TrimSpace(strings.Split(part, ";")[0]))
+		if tag == "" {
+			continue
+		}
+		if messages, ok := locales[tag]; ok {
+			return messages
+		}
+		if i := strings.IndexAny(tag, "-_"); i > 0 {
+			if messages, ok := locales[tag[:i]]; ok {
+				return messages
+			}
+		}
+	}
+	return locales[defaultLocale]
+}
+
+func formatValidationErrors(errs validator.ValidationErrors, locales map[string]map[string]string, locale string) []string {
+	messages := resolveLocale(locales, locale)
+	fallback := locales[defaultLocale]
+
+	var result []string
+	for _, err := range errs {
+		tmpl, ok := messages[err.Tag()]
+		if !ok {
+			tmpl, ok = fallback[err.Tag()]
+		}
+		if !ok {
+			continue
+		}
+		replacer := strings.NewReplacer("{field}", err.Field(), "{param}", err.Param())
+		result = append(result, replacer.Replace(tmpl))
+	}
+	return result
+}
@@ -0,0 +1,8 @@
+//go:build !libphonenumber
+
+package customvalidator
+
+import "github.com/go-playground/validator/v10"
+
+// Without the libphonenumber build tag only the regex based e164phone rule is available.
+func registerStrictPhoneValidation(v *validator.Validate) {}
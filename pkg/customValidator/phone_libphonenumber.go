@@ -0,0 +1,26 @@
+//go:build libphonenumber
+
+package customvalidator
+
+import (
+	"github.com/go-playground/validator/v10"
+	"github.com/nyaruka/phonenumbers"
+)
+
+// Registers e164phone_strict, which checks the number against the
+// per-country numbering plans shipped with libphonenumber.
+func registerStrictPhoneValidation(v *validator.Validate) {
+	v.RegisterValidation("e164phone_strict", validateE164PhoneStrict)
+}
+
+func validateE164PhoneStrict(fl validator.FieldLevel) bool {
+	value := fl.Field().String()
+	if !e164PhoneRegex.MatchString(value) {
+		return false
+	}
+	num, err := phonenumbers.Parse(value, "")
+	if err != nil {
+		return false
+	}
+	return phonenumbers.IsValidNumber(num)
+}
@@ -0,0 +1,39 @@
+//go:build libphonenumber
+
+package customvalidator
+
+import "testing"
+
+func TestE164PhoneStrict(t *testing.T) {
+	type input struct {
+		Phone string `validate:"e164phone_strict"`
+	}
+
+	cv := NewCustomValidator()
+	tests := []struct {
+		name  string
+		phone string
+		valid bool
+	}{
+		{"US", "+14155552671", true},
+		{"Thai mobile", "+66812345678", true},
+		{"UK", "+442071838750", true},
+		{"Japan", "+81312345678", true},
+		{"US unassigned area code", "+11235552671", false},
+		{"Thai too short", "+6681234", false},
+		{"UK too short", "+4420718387", false},
+		{"not E.164", "4155552671", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := cv.Validate(input{Phone: tt.phone})
+			if tt.valid && err != nil {
+				t.Fatalf("expected %q to be valid, got %v", tt.phone, err)
+			}
+			if !tt.valid && err == nil {
+				t.Fatalf("expected %q to be invalid", tt.phone)
+			}
+		})
+	}
+}
@@ -0,0 +1,74 @@
+//go:build activeurl
+
+package customvalidator
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"net/http"
+	"syscall"
+	"time"
+
+	"github.com/go-playground/validator/v10"
+)
+
+const activeURLTimeout = 5 * time.Second
+
+// active_url makes a server-side request to a user supplied URL, which is an
+// SSRF vector. The client below only dials public addresses (checked after DNS
+// resolution, so rebinding to an internal IP is caught too), ignores proxy
+// environment variables and never follows redirects.
+var activeURLClient = &http.Client{
+	Timeout: activeURLTimeout,
+	Transport: &http.Transport{
+		DialContext: (&net.Dialer{
+			Timeout: activeURLTimeout,
+			Control: rejectNonPublicAddress,
+		}).DialContext,
+		TLSHandshakeTimeout: activeURLTimeout,
+	},
+	CheckRedirect: func(req *http.Request, via []*http.Request) error {
+		return http.ErrUseLastResponse
+	},
+}
+
+func rejectNonPublicAddress(network, address string, _ syscall.RawConn) error {
+	host, _, err := net.SplitHostPort(address)
+	if err != nil {
+		return err
+	}
+	ip := net.ParseIP(host)
+	if ip == nil {
+		return fmt.Errorf("customvalidator: active_url cannot dial %q", address)
+	}
+	if ip.IsLoopback() || ip.IsPrivate() || ip.IsUnspecified() || ip.IsLinkLocalUnicast() ||
+		ip.IsLinkLocalMulticast() || ip.IsInterfaceLocalMulticast() || ip.IsMulticast() {
+		return fmt.Errorf("customvalidator: active_url refuses non-public address %s", ip)
+	}
+	return nil
+}
+
+// Sends a HEAD request and accepts any non 5xx response as reachable. A
+// redirect response counts as reachable but its target is not requested.
+func validateActiveURL(fl validator.FieldLevel) bool {
+	value := fl.Field().String()
+	if !isHTTPURL(value) {
+		return false
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), activeURLTimeout)
+	defer cancel()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodHead, value, nil)
+	if err != nil {
+		return false
+	}
+	resp, err := activeURLClient.Do(req)
+	if err != nil {
+		return false
+	}
+	defer resp.Body.Close()
+
+	return resp.StatusCode < http.StatusInternalServerError
+}
@@ -0,0 +1,52 @@
+//go:build activeurl
+
+package customvalidator
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestActiveURLRefusesLoopback(t *testing.T) {
+	type input struct {
+		Hook string `validate:"active_url"`
+	}
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+	defer srv.Close()
+
+	cv := NewCustomValidator()
+	if err := cv.Validate(input{Hook: srv.URL}); err == nil {
+		t.Fatal("expected a loopback URL to be rejected")
+	}
+}
+
+func TestRejectNonPublicAddress(t *testing.T) {
+	tests := []struct {
+		address string
+		allowed bool
+	}{
+		{"93.184.216.34:443", true},
+		{"[2606:2800:220:1:248:1893:25c8:1946]:443", true},
+		{"127.0.0.1:80", false},
+		{"[::1]:80", false},
+		{"10.0.0.5:80", false},
+		{"172.16.0.1:80", false},
+		{"192.168.1.1:80", false},
+		{"169.254.169.254:80", false},
+		{"0.0.0.0:80", false},
+		{"[fd00::1]:80", false},
+		{"[::ffff:127.0.0.1]:80", false},
+	}
+
+	for _, tt := range tests {
+		err := rejectNonPublicAddress("tcp", tt.address, nil)
+		if tt.allowed && err != nil {
+			t.Errorf("%s: expected allowed, got %v", tt.address, err)
+		}
+		if !tt.allowed && err == nil {
+			t.Errorf("%s: expected to be refused", tt.address)
+		}
+	}
+}
@@ -1,5 +1,7 @@
 package dbconnect
 
+import "time"
+
 type DBConfig struct {
 	Host       string
 	Port       string
@@ -7,4 +9,24 @@ type DBConfig struct {
 	Dbuser     string
 	Dbpassword string
 	Sslmode    string
+	Pool       PoolConfig
+	TLS        TLSConfig
+}
+
+// Zero values keep the database/sql defaults.
+type PoolConfig struct {
+	MaxOpenConns    int
+	MaxIdleConns    int
+	ConnMaxLifetime time.Duration
+	ConnMaxIdleTime time.Duration
+}
+
+// File paths for certificate based TLS. CertFile and KeyFile must be set together.
+// InsecureSkipVerify encrypts the connection without verifying the server certificate
+// and is rejected together with the verify-ca and verify-full modes.
+type TLSConfig struct {
+	CAFile             string
+	CertFile           string
+	KeyFile            string
+	InsecureSkipVerify bool
 }
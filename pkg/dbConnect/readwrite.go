@@ -0,0 +1,88 @@
+package dbconnect
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"math/rand"
+
+	"github.com/jmoiron/sqlx"
+)
+
+// ReadWriteDB sends writes to a primary and reads to one of the replicas.
+type ReadWriteDB struct {
+	writer  *sqlx.DB
+	readers []*sqlx.DB
+}
+
+var _ io.Closer = (*ReadWriteDB)(nil)
+
+func ConnectReadWriteSplit(writeCfg, readCfg DBConfig) (*ReadWriteDB, error) {
+	return connectReadWrite(writeCfg, []DBConfig{readCfg})
+}
+
+// RandomReader connects writeCfg as the writer and every readCfgs entry as a
+// replica, with Reader picking a random replica on every call.
+func RandomReader(writeCfg DBConfig, readCfgs []DBConfig) (*ReadWriteDB, error) {
+	if len(readCfgs) == 0 {
+		return nil, errors.New("dbconnect: at least one read replica config is required")
+	}
+	return connectReadWrite(writeCfg, readCfgs)
+}
+
+func connectReadWrite(writeCfg DBConfig, readCfgs []DBConfig) (*ReadWriteDB, error) {
+	writer, err := ConnectSqlx(writeCfg)
+	if err != nil {
+		return nil, fmt.Errorf("connect writer: %w", err)
+	}
+
+	rw := &ReadWriteDB{writer: writer}
+	for i, cfg := range readCfgs {
+		reader, err := ConnectSqlx(cfg)
+		if err != nil {
+			rw.Close()
+			return nil, fmt.Errorf("connect reader %d: %w", i, err)
+		}
+		rw.readers = append(rw.readers, reader)
+	}
+	return rw, nil
+}
+
+func (rw *ReadWriteDB) Writer() *sqlx.DB {
+	return rw.writer
+}
+
+func (rw *ReadWriteDB) Reader() *sqlx.DB {
+	switch len(rw.readers) {
+	case 0:
+		return rw.writer
+	case 1:
+		return rw.readers[0]
+	}
+	return rw.readers[rand.Intn(len(rw.readers))]
+}
+
+// Health checks the writer and every reader.
+func (rw *ReadWriteDB) Health(ctx context.Context) error {
+	if err := Health(ctx, rw.writer); err != nil {
+		return fmt.Errorf("writer: %w", err)
+	}
+	for i, reader := range rw.readers {
+		if err := Health(ctx, reader); err != nil {
+			return fmt.Errorf("reader %d: %w", i, err)
+		}
+	}
+	return nil
+}
+
+func (rw *ReadWriteDB) Close() error {
+	var errs []error
+	if rw.writer != nil {
+		errs = append(errs, rw.writer.Close())
+	}
+	for _, reader := range rw.readers {
+		errs = append(errs, reader.Close())
+	}
+	return errors.Join(errs...)
+}
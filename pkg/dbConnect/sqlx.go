@@ -1,7 +1,12 @@
 package dbconnect
 
 import (
+	"context"
+	"errors"
 	"fmt"
+	"os"
+	"strings"
+	"time"
 
 	"github.com/jmoiron/sqlx"
 	_ "github.com/lib/pq"
@@ -9,6 +14,62 @@ import (
 )
 
 func ConnectSqlx(dbConfig DBConfig) (db *sqlx.DB, err error) {
+	dsn, err := buildDSN(dbConfig)
+	if err != nil {
+		return nil, err
+	}
+	db, err = sqlx.Connect("nrpostgres", dsn)
+	if err != nil {
+		return nil, err
+	}
+	applyPoolConfig(db, dbConfig.Pool)
+
+	err = db.Ping()
+	if err != nil {
+		db.Close()
+		return nil, err
+	}
+	return
+}
+
+// ConnectSqlxWithRetry keeps trying to connect and ping, sleeping backoff between
+// attempts. Useful when the database container may still be starting.
+func ConnectSqlxWithRetry(cfg DBConfig, pool PoolConfig, maxAttempts int, backoff time.Duration) (*sqlx.DB, error) {
+	if maxAttempts < 1 {
+		maxAttempts = 1
+	}
+	cfg.Pool = pool
+
+	var err error
+	for attempt := 1; attempt <= maxAttempts; attempt++ {
+		var db *sqlx.DB
+		db, err = ConnectSqlx(cfg)
+		if err == nil {
+			return db, nil
+		}
+		if attempt < maxAttempts {
+			time.Sleep(backoff)
+		}
+	}
+	return nil, fmt.Errorf("connect database after %d attempts: %w", maxAttempts, err)
+}
+
+// Health runs SELECT 1 against the database.
+func Health(ctx context.Context, db *sqlx.DB) error {
+	var one int
+	return db.QueryRowContext(ctx, "SELECT 1").Scan(&one)
+}
+
+func buildDSN(dbConfig DBConfig) (string, error) {
+	if err := checkTLSConfig(dbConfig.Sslmode, dbConfig.TLS); err != nil {
+		return "", err
+	}
+
+	sslmode := dbConfig.Sslmode
+	if dbConfig.TLS.InsecureSkipVerify {
+		sslmode = "require"
+	}
+
 	dsn := fmt.Sprintf(
 		"host=%s port=%s user=%s password=%s dbname=%s sslmode=%s TimeZone=UTC",
 		dbConfig.Host,
@@ -16,15 +77,80 @@ func ConnectSqlx(dbConfig DBConfig) (db *sqlx.DB, err error) {
 		dbConfig.Dbuser,
 		dbConfig.Dbpassword,
 		dbConfig.Dbname,
-		dbConfig.Sslmode,
+		sslmode,
 	)
-	db, err = sqlx.Connect("nrpostgres", dsn)
+
+	tlsParams, err := buildTLSParams(dbConfig.TLS)
 	if err != nil {
-		return nil, err
+		return "", err
 	}
-	err = db.Ping()
+	return dsn + tlsParams, nil
+}
+
+// Rejects combinations where lib/pq would silently ignore part of the config.
+func checkTLSConfig(sslmode string, tlsConfig TLSConfig) error {
+	if (tlsConfig.CertFile == "") != (tlsConfig.KeyFile == "") {
+		return errors.New("tls: CertFile and KeyFile must be set together")
+	}
+	hasFiles := tlsConfig.CAFile != "" || tlsConfig.CertFile != "" || tlsConfig.KeyFile != ""
+	if sslmode == "disable" && (hasFiles || tlsConfig.InsecureSkipVerify) {
+		return errors.New("tls: TLS options are set but Sslmode is \"disable\"")
+	}
+	if tlsConfig.InsecureSkipVerify && (sslmode == "verify-ca" || sslmode == "verify-full") {
+		return fmt.Errorf("tls: InsecureSkipVerify cannot be combined with Sslmode %q", sslmode)
+	}
+	// lib/pq verifies against sslrootcert whenever it is given, even with sslmode=require.
+	if tlsConfig.InsecureSkipVerify && tlsConfig.CAFile != "" {
+		return errors.New("tls: InsecureSkipVerify cannot be combined with CAFile")
+	}
+	return nil
+}
+
+func buildTLSParams(tlsConfig TLSConfig) (string, error) {
+
+	var params strings.Builder
+	for _, p := range []struct{ key, path, name string }{
+		{"sslrootcert", tlsConfig.CAFile, "CA file"},
+		{"sslcert", tlsConfig.CertFile, "certificate file"},
+		{"sslkey", tlsConfig.KeyFile, "key file"},
+	} {
+		if p.path == "" {
+			continue
+		}
+		if err := checkReadable(p.path); err != nil {
+			return "", fmt.Errorf("tls: %s %q: %w", p.name, p.path, err)
+		}
+		params.WriteString(fmt.Sprintf(" %s=%s", p.key, quoteDSNValue(p.path)))
+	}
+	return params.String(), nil
+}
+
+func checkReadable(path string) error {
+	f, err := os.Open(path)
 	if err != nil {
-		return nil, err
+		return err
+	}
+	return f.Close()
+}
+
+// Quotes a value for a key=value connection string so paths with spaces survive.
+func quoteDSNValue(v string) string {
+	v = strings.ReplaceAll(v, `\`, `\\`)
+	v = strings.ReplaceAll(v, `'`, `\'`)
+	return "'" + v + "'"
+}
+
+func applyPoolConfig(db *sqlx.DB, pool PoolConfig) {
+	if pool.MaxOpenConns > 0 {
+		db.SetMaxOpenConns(pool.MaxOpenConns)
+	}
+	if pool.MaxIdleConns > 0 {
+		db.SetMaxIdleConns(pool.MaxIdleConns)
+	}
+	if pool.ConnMaxLifetime > 0 {
+		db.SetConnMaxLifetime(pool.ConnMaxLifetime)
+	}
+	if pool.ConnMaxIdleTime > 0 {
+		db.SetConnMaxIdleTime(pool.ConnMaxIdleTime)
 	}
-	return
 }
@@ -0,0 +1,188 @@
+package dbconnect
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+)
+
+// Writes a self-signed CA plus a client certificate and key signed by it.
+func writeTestCerts(t *testing.T) (caFile, certFile, keyFile string) {
+	t.Helper()
+	dir := t.TempDir()
+
+	caKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	caTemplate := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "dbconnect test CA"},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(time.Hour),
+		IsCA:                  true,
+		KeyUsage:              x509.KeyUsageCertSign,
+		BasicConstraintsValid: true,
+	}
+	caDER, err := x509.CreateCertificate(rand.Reader, caTemplate, caTemplate, &caKey.PublicKey, caKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	clientKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	clientTemplate := &x509.Certificate{
+		SerialNumber: big.NewInt(2),
+		Subject:      pkix.Name{CommonName: "app"},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+		KeyUsage:     x509.KeyUsageDigitalSignature,
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth},
+	}
+	clientDER, err := x509.CreateCertificate(rand.Reader, clientTemplate, caTemplate, &clientKey.PublicKey, caKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+	keyDER, err := x509.MarshalECPrivateKey(clientKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	writePEM := func(name, blockType string, der []byte) string {
+		path := filepath.Join(dir, name)
+		if err := os.WriteFile(path, pem.EncodeToMemory(&pem.Block{Type: blockType, Bytes: der}), 0o600); err != nil {
+			t.Fatal(err)
+		}
+		return path
+	}
+	return writePEM("ca.crt", "CERTIFICATE", caDER),
+		writePEM("client.crt", "CERTIFICATE", clientDER),
+		writePEM("client dir key.pem", "EC PRIVATE KEY", keyDER)
+}
+
+func TestBuildDSNWithTLS(t *testing.T) {
+	caFile, certFile, keyFile := writeTestCerts(t)
+	if _, err := tls.LoadX509KeyPair(certFile, keyFile); err != nil {
+		t.Fatalf("generated client certificate is unusable: %v", err)
+	}
+
+	dsn, err := buildDSN(DBConfig{
+		Host: "db", Port: "5432", Dbname: "app", Dbuser: "u", Dbpassword: "p",
+		Sslmode: "verify-full",
+		TLS:     TLSConfig{CAFile: caFile, CertFile: certFile, KeyFile: keyFile},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, want := range []string{
+		"sslmode=verify-full",
+		"sslrootcert='" + caFile + "'",
+		"sslcert='" + certFile + "'",
+		"sslkey='" + keyFile + "'",
+	} {
+		if !strings.Contains(dsn, want) {
+			t.Errorf("dsn %q does not contain %q", dsn, want)
+		}
+	}
+}
+
+func TestBuildDSNInsecureSkipVerify(t *testing.T) {
+	_, certFile, keyFile := writeTestCerts(t)
+
+	for _, sslmode := range []string{"verify-ca", "verify-full"} {
+		_, err := buildDSN(DBConfig{
+			Sslmode: sslmode,
+			TLS:     TLSConfig{CertFile: certFile, KeyFile: keyFile, InsecureSkipVerify: true},
+		})
+		if err == nil || !strings.Contains(err.Error(), sslmode) {
+			t.Fatalf("sslmode %s: err = %v, want a conflict error", sslmode, err)
+		}
+	}
+
+	for _, sslmode := range []string{"", "prefer", "require"} {
+		dsn, err := buildDSN(DBConfig{
+			Sslmode: sslmode,
+			TLS:     TLSConfig{CertFile: certFile, KeyFile: keyFile, InsecureSkipVerify: true},
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !strings.Contains(dsn, "sslmode=require") {
+			t.Fatalf("dsn %q should use sslmode=require", dsn)
+		}
+	}
+}
+
+func TestBuildDSNTLSErrors(t *testing.T) {
+	caFile, certFile, keyFile := writeTestCerts(t)
+	missing := filepath.Join(t.TempDir(), "missing.crt")
+
+	tests := []struct {
+		name    string
+		cfg     DBConfig
+		wantErr string
+	}{
+		{
+			"missing CA file",
+			DBConfig{Sslmode: "verify-ca", TLS: TLSConfig{CAFile: missing}},
+			"CA file",
+		},
+		{
+			"cert without key",
+			DBConfig{Sslmode: "verify-ca", TLS: TLSConfig{CertFile: certFile}},
+			"CertFile and KeyFile must be set together",
+		},
+		{
+			"files with sslmode disable",
+			DBConfig{Sslmode: "disable", TLS: TLSConfig{CAFile: caFile}},
+			"disable",
+		},
+		{
+			"insecure with sslmode disable",
+			DBConfig{Sslmode: "disable", TLS: TLSConfig{InsecureSkipVerify: true}},
+			"disable",
+		},
+		{
+			"insecure with CA file",
+			DBConfig{Sslmode: "require", TLS: TLSConfig{CAFile: caFile, CertFile: certFile, KeyFile: keyFile, InsecureSkipVerify: true}},
+			"InsecureSkipVerify cannot be combined with CAFile",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := buildDSN(tt.cfg)
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Fatalf("err = %v, want it to mention %q", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestBuildDSNUnreadableKey(t *testing.T) {
+	if os.Geteuid() == 0 {
+		t.Skip("root can read files regardless of permissions")
+	}
+	_, certFile, keyFile := writeTestCerts(t)
+	if err := os.Chmod(keyFile, 0); err != nil {
+		t.Fatal(err)
+	}
+
+	_, err := buildDSN(DBConfig{Sslmode: "verify-full", TLS: TLSConfig{CertFile: certFile, KeyFile: keyFile}})
+	if err == nil || !strings.Contains(err.Error(), "key file") {
+		t.Fatalf("err = %v, want a key file error", err)
+	}
+}
@@ -0,0 +1,77 @@
+package encryptor
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/base64"
+	"errors"
+)
+
+var (
+	ErrInvalidKeySize     = errors.New("encryptor: key must be 16, 24 or 32 bytes")
+	ErrCiphertextTooShort = errors.New("encryptor: ciphertext too short")
+)
+
+// EncryptAESGCM encrypts with AES-GCM (AES-128/192/256 depending on the key
+// length) and prepends the random 12 byte nonce to the ciphertext.
+func EncryptAESGCM(plaintext []byte, key []byte) (ciphertext []byte, err error) {
+	gcm, err := newGCM(key)
+	if err != nil {
+		return nil, err
+	}
+
+	nonce := make([]byte, gcm.NonceSize())
+	if _, err := rand.Read(nonce); err != nil {
+		return nil, err
+	}
+	return gcm.Seal(nonce, nonce, plaintext, nil), nil
+}
+
+func DecryptAESGCM(ciphertext []byte, key []byte) (plaintext []byte, err error) {
+	gcm, err := newGCM(key)
+	if err != nil {
+		return nil, err
+	}
+
+	nonceSize := gcm.NonceSize()
+	if len(ciphertext) < nonceSize+gcm.Overhead() {
+		return nil, ErrCiphertextTooShort
+	}
+	return gcm.Open(nil, ciphertext[:nonceSize], ciphertext[nonceSize:], nil)
+}
+
+// EncryptString is EncryptAESGCM with base64 (standard encoding) output.
+func EncryptString(plaintext string, key []byte) (string, error) {
+	ciphertext, err := EncryptAESGCM([]byte(plaintext), key)
+	if err != nil {
+		return "", err
+	}
+	return base64.StdEncoding.EncodeToString(ciphertext), nil
+}
+
+func DecryptString(ciphertext string, key []byte) (string, error) {
+	raw, err := base64.StdEncoding.DecodeString(ciphertext)
+	if err != nil {
+		return "", err
+	}
+	plaintext, err := DecryptAESGCM(raw, key)
+	if err != nil {
+		return "", err
+	}
+	return string(plaintext), nil
+}
+
+func newGCM(key []byte) (cipher.AEAD, error) {
+	switch len(key) {
+	case 16, 24, 32:
+	default:
+		return nil, ErrInvalidKeySize
+	}
+
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+	return cipher.NewGCM(block)
+}
@@ -0,0 +1,118 @@
+package encryptor
+
+import (
+	"bytes"
+	"encoding/base64"
+	"errors"
+	"testing"
+)
+
+func TestAESGCMRoundTrip(t *testing.T) {
+	plaintexts := [][]byte{nil, []byte("x"), []byte("patient record 12345"), bytes.Repeat([]byte{0xff}, 1000)}
+
+	for _, size := range []int{16, 24, 32} {
+		key := bytes.Repeat([]byte{byte(size)}, size)
+		for _, plaintext := range plaintexts {
+			ciphertext, err := EncryptAESGCM(plaintext, key)
+			if err != nil {
+				t.Fatalf("%d byte key: %v", size, err)
+			}
+			if want := 12 + len(plaintext) + 16; len(ciphertext) != want {
+				t.Fatalf("%d byte key: ciphertext is %d bytes, want %d", size, len(ciphertext), want)
+			}
+			got, err := DecryptAESGCM(ciphertext, key)
+			if err != nil {
+				t.Fatalf("%d byte key: %v", size, err)
+			}
+			if !bytes.Equal(got, plaintext) {
+				t.Fatalf("%d byte key: got %q, want %q", size, got, plaintext)
+			}
+		}
+	}
+}
+
+func TestAESGCMInvalidKeySize(t *testing.T) {
+	for _, size := range []int{0, 8, 15, 17, 31, 33, 64} {
+		key := make([]byte, size)
+		if _, err := EncryptAESGCM([]byte("x"), key); !errors.Is(err, ErrInvalidKeySize) {
+			t.Errorf("EncryptAESGCM with %d byte key: err = %v", size, err)
+		}
+		if _, err := DecryptAESGCM(make([]byte, 64), key); !errors.Is(err, ErrInvalidKeySize) {
+			t.Errorf("DecryptAESGCM with %d byte key: err = %v", size, err)
+		}
+	}
+}
+
+func TestAESGCMCiphertextTooShort(t *testing.T) {
+	key := make([]byte, 32)
+	for _, size := range []int{0, 11, 12, 27} {
+		if _, err := DecryptAESGCM(make([]byte, size), key); !errors.Is(err, ErrCiphertextTooShort) {
+			t.Errorf("%d byte ciphertext: err = %v", size, err)
+		}
+	}
+}
+
+func TestAESGCMTampered(t *testing.T) {
+	key := make([]byte, 32)
+	ciphertext, err := EncryptAESGCM([]byte("amount=100"), key)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, i := range []int{0, 12, len(ciphertext) - 1} {
+		tampered := append([]byte(nil), ciphertext...)
+		tampered[i] ^= 1
+		if _, err := DecryptAESGCM(tampered, key); err == nil {
+			t.Errorf("flipping byte %d was not detected", i)
+		}
+	}
+
+	otherKey := bytes.Repeat([]byte{1}, 32)
+	if _, err := DecryptAESGCM(ciphertext, otherKey); err == nil {
+		t.Error("decrypting with the wrong key succeeded")
+	}
+}
+
+func TestAESGCMRandomNonce(t *testing.T) {
+	key := make([]byte, 16)
+	a, err := EncryptAESGCM([]byte("same"), key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	b, err := EncryptAESGCM([]byte("same"), key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if bytes.Equal(a, b) || bytes.Equal(a[:12], b[:12]) {
+		t.Fatal("two encryptions of the same plaintext share a nonce")
+	}
+}
+
+func TestEncryptDecryptString(t *testing.T) {
+	key := bytes.Repeat([]byte("k"), 32)
+
+	ciphertext, err := EncryptString("ผู้ป่วย", key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := base64.StdEncoding.DecodeString(ciphertext); err != nil {
+		t.Fatalf("EncryptString output is not standard base64: %v", err)
+	}
+	got, err := DecryptString(ciphertext, key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got != "ผู้ป่วย" {
+		t.Fatalf("got %q", got)
+	}
+
+	var corrupt base64.CorruptInputError
+	for _, in := range []string{"not base64!", "abc", ciphertext[:len(ciphertext)-1] + "*"} {
+		if _, err := DecryptString(in, key); !errors.As(err, &corrupt) {
+			t.Errorf("DecryptString(%q) err = %v, want a base64 error", in, err)
+		}
+	}
+	if _, err := DecryptString(base64.StdEncoding.EncodeToString([]byte("short")), key); !errors.Is(err, ErrCiphertextTooShort) {
+		t.Errorf("short ciphertext: err = %v", err)
+	}
+}
@@ -0,0 +1,159 @@
+package encryptor
+
+import (
+	"crypto/rand"
+	"crypto/subtle"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"strings"
+
+	"golang.org/x/crypto/argon2"
+	"golang.org/x/crypto/bcrypt"
+)
+
+var (
+	ErrInvalidArgon2Hash = errors.New("encryptor: invalid argon2id hash")
+	ErrUnknownHashFormat = errors.New("encryptor: unknown password hash format")
+)
+
+const argon2SaltLen = 16
+
+// Upper bound for the memory parameter, 1 GiB in KiB, so a crafted stored
+// hash cannot make ComparePasswordArgon2 allocate an arbitrary amount.
+const argon2MaxMemory = 1024 * 1024
+
+// Upper bound for the number of passes, for the same reason as argon2MaxMemory.
+const argon2MaxTime = 32
+
+type Argon2Params struct {
+	// Memory in KiB
+	Memory  uint32
+	Time    uint32
+	Threads uint8
+	KeyLen  uint32
+}
+
+// 64 MB, 3 iterations, 4 threads, 32 byte key.
+func DefaultArgon2Params() *Argon2Params {
+	return &Argon2Params{
+		Memory:  64 * 1024,
+		Time:    3,
+		Threads: 4,
+		KeyLen:  32,
+	}
+}
+
+// HashPasswordArgon2 hashes with argon2id and returns a PHC string such as
+// $argon2id$v=19$m=65536,t=3,p=4$<salt>$<hash>. A nil params uses DefaultArgon2Params.
+func HashPasswordArgon2(password string, params *Argon2Params) (string, error) {
+	if params == nil {
+		params = DefaultArgon2Params()
+	}
+	if err := checkArgon2Params(params); err != nil {
+		return "", err
+	}
+	if params.KeyLen < 1 {
+		return "", errors.New("encryptor: argon2 KeyLen must be at least 1")
+	}
+
+	salt := make([]byte, argon2SaltLen)
+	if _, err := rand.Read(salt); err != nil {
+		return "", err
+	}
+	key := argon2.IDKey([]byte(password), salt, params.Time, params.Memory, params.Threads, params.KeyLen)
+
+	return fmt.Sprintf("$argon2id$v=%d$m=%d,t=%d,p=%d$%s$%s",
+		argon2.Version,
+		params.Memory, params.Time, params.Threads,
+		base64.RawStdEncoding.EncodeToString(salt),
+		base64.RawStdEncoding.EncodeToString(key),
+	), nil
+}
+
+// ComparePasswordArgon2 returns bcrypt.ErrMismatchedHashAndPassword on a wrong
+// password so callers can check one error for both algorithms.
+func ComparePasswordArgon2(password, hash string) error {
+	params, salt, key, err := decodeArgon2Hash(hash)
+	if err != nil {
+		return err
+	}
+
+	other := argon2.IDKey([]byte(password), salt, params.Time, params.Memory, params.Threads, params.KeyLen)
+	if subtle.ConstantTimeCompare(key, other) != 1 {
+		return bcrypt.ErrMismatchedHashAndPassword
+	}
+	return nil
+}
+
+func decodeArgon2Hash(hash string) (*Argon2Params, []byte, []byte, error) {
+	parts := strings.Split(hash, "$")
+	if len(parts) != 6 || parts[1] != "argon2id" {
+		return nil, nil, nil, ErrInvalidArgon2Hash
+	}
+
+	var version int
+	if _, err := fmt.Sscanf(parts[2], "v=%d", &version); err != nil || version != argon2.Version {
+		return nil, nil, nil, ErrInvalidArgon2Hash
+	}
+
+	params := &Argon2Params{}
+	if _, err := fmt.Sscanf(parts[3], "m=%d,t=%d,p=%d", &params.Memory, &params.Time, &params.Threads); err != nil {
+		return nil, nil, nil, ErrInvalidArgon2Hash
+	}
+	if err := checkArgon2Params(params); err != nil {
+		return nil, nil, nil, ErrInvalidArgon2Hash
+	}
+
+	salt, err := base64.RawStdEncoding.DecodeString(parts[4])
+	if err != nil {
+		return nil, nil, nil, ErrInvalidArgon2Hash
+	}
+	key, err := base64.RawStdEncoding.DecodeString(parts[5])
+	if err != nil || len(key) == 0 {
+		return nil, nil, nil, ErrInvalidArgon2Hash
+	}
+	params.KeyLen = uint32(len(key))
+
+	return params, salt, key, nil
+}
+
+// argon2.IDKey panics when Time or Threads is zero, and RFC 9106 requires at
+// least 8 KiB of memory per thread.
+func checkArgon2Params(params *Argon2Params) error {
+	switch {
+	case params.Time < 1:
+		return errors.New("encryptor: argon2 Time must be at least 1")
+	case params.Time > argon2MaxTime:
+		return fmt.Errorf("encryptor: argon2 Time must not exceed %d", argon2MaxTime)
+	case params.Threads < 1:
+		return errors.New("encryptor: argon2 Threads must be at least 1")
+	case params.Memory < 8*uint32(params.Threads):
+		return errors.New("encryptor: argon2 Memory must be at least 8 KiB per thread")
+	case params.Memory > argon2MaxMemory:
+		return fmt.Errorf("encryptor: argon2 Memory must not exceed %d KiB", argon2MaxMemory)
+	}
+	return nil
+}
+
+func IsArgon2Hash(s string) bool {
+	return strings.HasPrefix(s, "$argon2id$")
+}
+
+func IsBcryptHash(s string) bool {
+	if len(s) != 60 {
+		return false
+	}
+	return strings.HasPrefix(s, "$2a$") || strings.HasPrefix(s, "$2b$") || strings.HasPrefix(s, "$2y$")
+}
+
+// ComparePasswordAny detects whether hash is argon2id or bcrypt and compares accordingly.
+func ComparePasswordAny(password, hash string) error {
+	switch {
+	case IsArgon2Hash(hash):
+		return ComparePasswordArgon2(password, hash)
+	case IsBcryptHash(hash):
+		return ComparePassword(password, hash)
+	}
+	return ErrUnknownHashFormat
+}
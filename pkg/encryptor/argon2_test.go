@@ -0,0 +1,70 @@
+package encryptor
+
+import (
+	"errors"
+	"testing"
+
+	"golang.org/x/crypto/bcrypt"
+)
+
+var testArgon2Params = &Argon2Params{Memory: 64, Time: 1, Threads: 1, KeyLen: 16}
+
+func TestArgon2RoundTrip(t *testing.T) {
+	hash, err := HashPasswordArgon2("s3cret", testArgon2Params)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := ComparePasswordArgon2("s3cret", hash); err != nil {
+		t.Fatalf("matching password: %v", err)
+	}
+	if err := ComparePasswordArgon2("wrong", hash); !errors.Is(err, bcrypt.ErrMismatchedHashAndPassword) {
+		t.Fatalf("wrong password: %v", err)
+	}
+}
+
+func TestHashPasswordArgon2RejectsInvalidParams(t *testing.T) {
+	tests := []struct {
+		name   string
+		params Argon2Params
+	}{
+		{"zero time", Argon2Params{Memory: 64, Time: 0, Threads: 1, KeyLen: 16}},
+		{"zero threads", Argon2Params{Memory: 64, Time: 1, Threads: 0, KeyLen: 16}},
+		{"memory below 8 KiB per thread", Argon2Params{Memory: 31, Time: 1, Threads: 4, KeyLen: 16}},
+		{"memory above cap", Argon2Params{Memory: argon2MaxMemory + 1, Time: 1, Threads: 1, KeyLen: 16}},
+		{"zero key length", Argon2Params{Memory: 64, Time: 1, Threads: 1, KeyLen: 0}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := HashPasswordArgon2("s3cret", &tt.params); err == nil {
+				t.Fatal("expected an error")
+			}
+		})
+	}
+}
+
+func TestComparePasswordArgon2RejectsCraftedHash(t *testing.T) {
+	const saltAndKey = "$c29tZXNhbHRzb21lc2FsdA$c29tZWtleXNvbWVrZXk"
+	tests := []struct {
+		name string
+		hash string
+	}{
+		{"zero time", "$argon2id$v=19$m=64,t=0,p=1" + saltAndKey},
+		{"huge time", "$argon2id$v=19$m=64,t=4294967295,p=1" + saltAndKey},
+		{"time above limit", "$argon2id$v=19$m=64,t=33,p=1" + saltAndKey},
+		{"zero threads", "$argon2id$v=19$m=64,t=1,p=0" + saltAndKey},
+		{"memory below 8 KiB per thread", "$argon2id$v=19$m=8,t=1,p=4" + saltAndKey},
+		{"huge memory", "$argon2id$v=19$m=4294967295,t=1,p=1" + saltAndKey},
+		{"threads overflow", "$argon2id$v=19$m=64,t=1,p=256" + saltAndKey},
+		{"wrong version", "$argon2id$v=16$m=64,t=1,p=1" + saltAndKey},
+		{"argon2i", "$argon2i$v=19$m=64,t=1,p=1" + saltAndKey},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := ComparePasswordArgon2("s3cret", tt.hash); !errors.Is(err, ErrInvalidArgon2Hash) {
+				t.Fatalf("err = %v, want ErrInvalidArgon2Hash", err)
+			}
+		})
+	}
+}
@@ -0,0 +1,43 @@
+package encryptor
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"crypto/sha512"
+	"crypto/subtle"
+	"encoding/base64"
+	"encoding/hex"
+	"hash"
+)
+
+func SignHMAC256(message, key []byte) []byte {
+	return signHMAC(sha256.New, message, key)
+}
+
+func SignHMAC512(message, key []byte) []byte {
+	return signHMAC(sha512.New, message, key)
+}
+
+func VerifyHMAC256(message, sig, key []byte) bool {
+	return subtle.ConstantTimeCompare(SignHMAC256(message, key), sig) == 1
+}
+
+func VerifyHMAC512(message, sig, key []byte) bool {
+	return subtle.ConstantTimeCompare(SignHMAC512(message, key), sig) == 1
+}
+
+// HexSign256 returns the HMAC-SHA256 signature hex encoded, e.g. for webhook headers.
+func HexSign256(message, key []byte) string {
+	return hex.EncodeToString(SignHMAC256(message, key))
+}
+
+// Base64Sign256 returns the HMAC-SHA256 signature base64 (standard encoding) encoded.
+func Base64Sign256(message, key []byte) string {
+	return base64.StdEncoding.EncodeToString(SignHMAC256(message, key))
+}
+
+func signHMAC(h func() hash.Hash, message, key []byte) []byte {
+	mac := hmac.New(h, key)
+	mac.Write(message)
+	return mac.Sum(nil)
+}
@@ -0,0 +1,116 @@
+package encryptor
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/hex"
+	"testing"
+)
+
+// Test vectors from RFC 4231 (HMAC-SHA256 and HMAC-SHA512).
+var hmacVectors = []struct {
+	name   string
+	key    []byte
+	data   []byte
+	sha256 string
+	sha512 string
+}{
+	{
+		name:   "test case 1",
+		key:    bytes.Repeat([]byte{0x0b}, 20),
+		data:   []byte("Hi There"),
+		sha256: "b0344c61d8db38535ca8afceaf0bf12b881dc200c9833da726e9376c2e32cff7",
+		sha512: "87aa7cdea5ef619d4ff0b4241a1d6cb02379f4e2ce4ec2787ad0b30545e17cde" +
+			"daa833b7d6b8a702038b274eaea3f4e4be9d914eeb61f1702e696c203a126854",
+	},
+	{
+		name:   "test case 2",
+		key:    []byte("Jefe"),
+		data:   []byte("what do ya want for nothing?"),
+		sha256: "5bdcc146bf60754e6a042426089575c75a003f089d2739839dec58b964ec3843",
+		sha512: "164b7a7bfcf819e2e395fbe73b56e0a387bd64222e831fd610270cd7ea250554" +
+			"9758bf75c05a994a6d034f65f8f0e6fdcaeab1a34d4a6b4b636e070a38bce737",
+	},
+	{
+		name:   "test case 3",
+		key:    bytes.Repeat([]byte{0xaa}, 20),
+		data:   bytes.Repeat([]byte{0xdd}, 50),
+		sha256: "773ea91e36800e46854db8ebd09181a72959098b3ef8c122d9635514ced565fe",
+		sha512: "fa73b0089d56a284efb0f0756c890be9b1b5dbdd8ee81a3655f83e33b2279d39" +
+			"bf3e848279a722c806b485a47e67c807b946a337bee8942674278859e13292fb",
+	},
+	{
+		name:   "test case 6",
+		key:    bytes.Repeat([]byte{0xaa}, 131),
+		data:   []byte("Test Using Larger Than Block-Size Key - Hash Key First"),
+		sha256: "60e431591ee0b67f0d8a26aacbf5b77f8e0bc6213728c5140546040f0ee37f54",
+		sha512: "80b24263c7c1a3ebb71493c1dd7be8b49b46d1f41b4aeec1121b013783f8f352" +
+			"6b56d037e05f2598bd0fd2215d6a1e5295e64f73f63f0aec8b915a985d786598",
+	},
+}
+
+func mustHex(t *testing.T, s string) []byte {
+	t.Helper()
+	b, err := hex.DecodeString(s)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return b
+}
+
+func TestHMACVectors(t *testing.T) {
+	for _, v := range hmacVectors {
+		t.Run(v.name, func(t *testing.T) {
+			want256 := mustHex(t, v.sha256)
+			want512 := mustHex(t, v.sha512)
+
+			if got := SignHMAC256(v.data, v.key); !bytes.Equal(got, want256) {
+				t.Errorf("SignHMAC256 = %x, want %s", got, v.sha256)
+			}
+			if got := SignHMAC512(v.data, v.key); !bytes.Equal(got, want512) {
+				t.Errorf("SignHMAC512 = %x, want %s", got, v.sha512)
+			}
+			if got := HexSign256(v.data, v.key); got != v.sha256 {
+				t.Errorf("HexSign256 = %s, want %s", got, v.sha256)
+			}
+			if got := Base64Sign256(v.data, v.key); got != base64.StdEncoding.EncodeToString(want256) {
+				t.Errorf("Base64Sign256 = %s", got)
+			}
+			if !VerifyHMAC256(v.data, want256, v.key) {
+				t.Error("VerifyHMAC256 rejected the RFC signature")
+			}
+			if !VerifyHMAC512(v.data, want512, v.key) {
+				t.Error("VerifyHMAC512 rejected the RFC signature")
+			}
+		})
+	}
+}
+
+func TestVerifyHMACRejects(t *testing.T) {
+	key := []byte("Jefe")
+	msg := []byte("what do ya want for nothing?")
+	sig256 := SignHMAC256(msg, key)
+	sig512 := SignHMAC512(msg, key)
+
+	tampered := append([]byte(nil), sig256...)
+	tampered[0] ^= 1
+
+	tests := []struct {
+		name string
+		ok   bool
+	}{
+		{"wrong key", VerifyHMAC256(msg, sig256, []byte("jefe"))},
+		{"wrong message", VerifyHMAC256([]byte("what do ya want for nothing!"), sig256, key)},
+		{"tampered signature", VerifyHMAC256(msg, tampered, key)},
+		{"truncated signature", VerifyHMAC256(msg, sig256[:16], key)},
+		{"empty signature", VerifyHMAC256(msg, nil, key)},
+		{"sha512 signature checked as sha256", VerifyHMAC256(msg, sig512, key)},
+		{"sha256 signature checked as sha512", VerifyHMAC512(msg, sig256, key)},
+	}
+
+	for _, tt := range tests {
+		if tt.ok {
+			t.Errorf("%s: expected verification to fail", tt.name)
+		}
+	}
+}
@@ -0,0 +1,185 @@
+package encryptor
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"strings"
+	"time"
+)
+
+var (
+	ErrInvalidToken     = errors.New("encryptor: invalid token")
+	ErrInvalidSignature = errors.New("encryptor: invalid token signature")
+	ErrTokenExpired     = errors.New("encryptor: token expired")
+	ErrWrongTokenType   = errors.New("encryptor: wrong token type")
+)
+
+const refreshTokenType = "refresh"
+
+var jwtHeader = base64.RawURLEncoding.EncodeToString([]byte(`{"alg":"HS256","typ":"JWT"}`))
+
+type TokenPair struct {
+	AccessToken      string
+	RefreshToken     string
+	AccessExpiresAt  time.Time
+	RefreshExpiresAt time.Time
+}
+
+// CreateJWT signs claims with HMAC-SHA256. "iat" is always set, and "exp" is
+// set when expiry is greater than zero, overriding any value in claims.
+func CreateJWT(claims map[string]interface{}, secret []byte, expiry time.Duration) (string, error) {
+	now := time.Now()
+	payload := make(map[string]interface{}, len(claims)+2)
+	for k, v := range claims {
+		payload[k] = v
+	}
+	payload["iat"] = now.Unix()
+	if expiry > 0 {
+		payload["exp"] = now.Add(expiry).Unix()
+	}
+
+	body, err := json.Marshal(payload)
+	if err != nil {
+		return "", err
+	}
+
+	unsigned := jwtHeader + "." + base64.RawURLEncoding.EncodeToString(body)
+	signature := base64.RawURLEncoding.EncodeToString(SignHMAC256([]byte(unsigned), secret))
+	return unsigned + "." + signature, nil
+}
+
+// ValidateJWT checks the HS256 signature, then "exp" and "nbf" when present.
+// Either claim must be a number, a token carrying e.g. a string "exp" is invalid.
+// Refresh tokens from CreateTokenPair are rejected with ErrWrongTokenType so
+// they cannot be used to authorize requests; use ValidateRefreshJWT for them.
+func ValidateJWT(token string, secret []byte) (map[string]interface{}, error) {
+	claims, err := validateJWT(token, secret)
+	if err != nil {
+		return nil, err
+	}
+	if claims["typ"] == refreshTokenType {
+		return nil, ErrWrongTokenType
+	}
+	return claims, nil
+}
+
+// ValidateRefreshJWT is ValidateJWT for refresh tokens: it only accepts tokens
+// carrying "typ":"refresh".
+func ValidateRefreshJWT(token string, secret []byte) (map[string]interface{}, error) {
+	claims, err := validateJWT(token, secret)
+	if err != nil {
+		return nil, err
+	}
+	if claims["typ"] != refreshTokenType {
+		return nil, ErrWrongTokenType
+	}
+	return claims, nil
+}
+
+func validateJWT(token string, secret []byte) (map[string]interface{}, error) {
+	parts := strings.Split(token, ".")
+	if len(parts) != 3 {
+		return nil, ErrInvalidToken
+	}
+
+	var header struct {
+		Alg string `json:"alg"`
+	}
+	if err := decodeJWTSegment(parts[0], &header); err != nil {
+		return nil, err
+	}
+	if header.Alg != "HS256" {
+		return nil, ErrInvalidToken
+	}
+
+	signature, err := base64.RawURLEncoding.DecodeString(parts[2])
+	if err != nil {
+		return nil, ErrInvalidToken
+	}
+	if !VerifyHMAC256([]byte(parts[0]+"."+parts[1]), signature, secret) {
+		return nil, ErrInvalidSignature
+	}
+
+	claims := map[string]interface{}{}
+	if err := decodeJWTSegment(parts[1], &claims); err != nil {
+		return nil, err
+	}
+
+	now := time.Now().Unix()
+	if v, present := claims["exp"]; present {
+		exp, ok := v.(float64)
+		if !ok {
+			return nil, ErrInvalidToken
+		}
+		if now >= int64(exp) {
+			return nil, ErrTokenExpired
+		}
+	}
+	if v, present := claims["nbf"]; present {
+		nbf, ok := v.(float64)
+		if !ok || now < int64(nbf) {
+			return nil, ErrInvalidToken
+		}
+	}
+	return claims, nil
+}
+
+// ParseJWTUnsafe decodes the claims WITHOUT verifying the signature or expiry.
+// Only use it where the token was already verified, e.g. audit logging.
+func ParseJWTUnsafe(token string) (map[string]interface{}, error) {
+	parts := strings.Split(token, ".")
+	if len(parts) != 3 {
+		return nil, ErrInvalidToken
+	}
+
+	claims := map[string]interface{}{}
+	if err := decodeJWTSegment(parts[1], &claims); err != nil {
+		return nil, err
+	}
+	return claims, nil
+}
+
+// CreateTokenPair creates an access token and a longer lived refresh token for
+// the same claims. The refresh token carries "typ":"refresh" and a random "jti".
+func CreateTokenPair(claims map[string]interface{}, secret []byte, accessExpiry, refreshExpiry time.Duration) (*TokenPair, error) {
+	now := time.Now()
+	access, err := CreateJWT(claims, secret, accessExpiry)
+	if err != nil {
+		return nil, err
+	}
+
+	jti, err := GenerateToken(16)
+	if err != nil {
+		return nil, err
+	}
+	refreshClaims := make(map[string]interface{}, len(claims)+2)
+	for k, v := range claims {
+		refreshClaims[k] = v
+	}
+	refreshClaims["typ"] = refreshTokenType
+	refreshClaims["jti"] = jti
+
+	refresh, err := CreateJWT(refreshClaims, secret, refreshExpiry)
+	if err != nil {
+		return nil, err
+	}
+
+	return &TokenPair{
+		AccessToken:      access,
+		RefreshToken:     refresh,
+		AccessExpiresAt:  now.Add(accessExpiry),
+		RefreshExpiresAt: now.Add(refreshExpiry),
+	}, nil
+}
+
+func decodeJWTSegment(segment string, dst interface{}) error {
+	raw, err := base64.RawURLEncoding.DecodeString(segment)
+	if err != nil {
+		return ErrInvalidToken
+	}
+	if err := json.Unmarshal(raw, dst); err != nil {
+		return ErrInvalidToken
+	}
+	return nil
+}
@@ -0,0 +1,86 @@
+package encryptor
+
+import (
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestTokenPairTypes(t *testing.T) {
+	secret := []byte("test-secret")
+	pair, err := CreateTokenPair(map[string]interface{}{"sub": "user-1"}, secret, time.Minute, time.Hour)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	claims, err := ValidateJWT(pair.AccessToken, secret)
+	if err != nil {
+		t.Fatalf("access token: %v", err)
+	}
+	if claims["sub"] != "user-1" {
+		t.Fatalf("sub = %v", claims["sub"])
+	}
+	if _, err := ValidateJWT(pair.RefreshToken, secret); !errors.Is(err, ErrWrongTokenType) {
+		t.Fatalf("refresh token as access token: err = %v, want ErrWrongTokenType", err)
+	}
+
+	claims, err = ValidateRefreshJWT(pair.RefreshToken, secret)
+	if err != nil {
+		t.Fatalf("refresh token: %v", err)
+	}
+	if claims["jti"] == "" || claims["jti"] == nil {
+		t.Fatal("refresh token has no jti")
+	}
+	if _, err := ValidateRefreshJWT(pair.AccessToken, secret); !errors.Is(err, ErrWrongTokenType) {
+		t.Fatalf("access token as refresh token: err = %v, want ErrWrongTokenType", err)
+	}
+}
+
+func TestValidateJWTErrors(t *testing.T) {
+	secret := []byte("test-secret")
+	token, err := CreateJWT(map[string]interface{}{"sub": "user-1"}, secret, time.Minute)
+	if err != nil {
+		t.Fatal(err)
+	}
+	expired, err := CreateJWT(map[string]interface{}{"exp": time.Now().Add(-time.Minute).Unix()}, secret, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := ValidateJWT(token, []byte("other")); !errors.Is(err, ErrInvalidSignature) {
+		t.Errorf("wrong secret: %v", err)
+	}
+	if _, err := ValidateJWT(expired, secret); !errors.Is(err, ErrTokenExpired) {
+		t.Errorf("expired: %v", err)
+	}
+	if _, err := ValidateRefreshJWT(expired, secret); !errors.Is(err, ErrTokenExpired) {
+		t.Errorf("expired refresh check: %v", err)
+	}
+	if _, err := ValidateJWT("not.a.jwt", secret); !errors.Is(err, ErrInvalidToken) {
+		t.Errorf("garbage: %v", err)
+	}
+
+	for _, claims := range []map[string]interface{}{
+		{"exp": "2099-01-01"},
+		{"exp": nil},
+		{"exp": true},
+		{"nbf": "0"},
+		{"nbf": nil},
+	} {
+		malformed, err := CreateJWT(claims, secret, 0)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if _, err := ValidateJWT(malformed, secret); !errors.Is(err, ErrInvalidToken) {
+			t.Errorf("non-numeric %v: %v", claims, err)
+		}
+	}
+
+	notYet, err := CreateJWT(map[string]interface{}{"nbf": time.Now().Add(time.Hour).Unix()}, secret, time.Minute)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := ValidateJWT(notYet, secret); !errors.Is(err, ErrInvalidToken) {
+		t.Errorf("not yet valid: %v", err)
+	}
+}
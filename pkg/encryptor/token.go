@@ -0,0 +1,71 @@
+package encryptor
+
+import (
+	"crypto/rand"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"math/big"
+	"strings"
+)
+
+var ErrInvalidLength = errors.New("encryptor: length must be greater than zero")
+
+// GenerateToken returns byteLen random bytes, URL-safe base64 encoded without padding.
+func GenerateToken(byteLen int) (string, error) {
+	b, err := randomBytes(byteLen)
+	if err != nil {
+		return "", err
+	}
+	return base64.RawURLEncoding.EncodeToString(b), nil
+}
+
+func GenerateHexToken(byteLen int) (string, error) {
+	b, err := randomBytes(byteLen)
+	if err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(b), nil
+}
+
+// GenerateOTP returns exactly digits numeric characters, leading zeros included.
+func GenerateOTP(digits int) (string, error) {
+	if digits <= 0 {
+		return "", ErrInvalidLength
+	}
+
+	var sb strings.Builder
+	ten := big.NewInt(10)
+	for i := 0; i < digits; i++ {
+		n, err := rand.Int(rand.Reader, ten)
+		if err != nil {
+			return "", err
+		}
+		sb.WriteByte(byte('0' + n.Int64()))
+	}
+	return sb.String(), nil
+}
+
+// GenerateAPIKey returns keys like sk_live_<token>. An underscore is added
+// after prefix unless it already ends with one.
+func GenerateAPIKey(prefix string, byteLen int) (string, error) {
+	token, err := GenerateToken(byteLen)
+	if err != nil {
+		return "", err
+	}
+	if prefix != "" && !strings.HasSuffix(prefix, "_") {
+		prefix += "_"
+	}
+	return prefix + token, nil
+}
+
+func randomBytes(n int) ([]byte, error) {
+	if n <= 0 {
+		return nil, ErrInvalidLength
+	}
+	b := make([]byte, n)
+	if _, err := rand.Read(b); err != nil {
+		return nil, err
+	}
+	return b, nil
+}
@@ -0,0 +1,123 @@
+package encryptor
+
+import (
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"strings"
+	"testing"
+)
+
+func TestGenerateOTP(t *testing.T) {
+	for _, digits := range []int{1, 4, 6, 8, 20} {
+		otp, err := GenerateOTP(digits)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(otp) != digits || strings.Trim(otp, "0123456789") != "" {
+			t.Fatalf("GenerateOTP(%d) = %q", digits, otp)
+		}
+	}
+
+	// The first digit is uniform over 0-9, so 1000 draws without a leading
+	// zero would mean the OTP is generated as a number and lost its zeros.
+	leadingZero := false
+	seen := map[byte]bool{}
+	for i := 0; i < 1000; i++ {
+		otp, err := GenerateOTP(6)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(otp) != 6 {
+			t.Fatalf("GenerateOTP(6) = %q", otp)
+		}
+		leadingZero = leadingZero || otp[0] == '0'
+		for j := 0; j < len(otp); j++ {
+			seen[otp[j]] = true
+		}
+	}
+	if !leadingZero {
+		t.Error("no OTP with a leading zero in 1000 draws")
+	}
+	if len(seen) != 10 {
+		t.Errorf("only digits %v appeared in 1000 draws", seen)
+	}
+}
+
+func TestGenerateInvalidLength(t *testing.T) {
+	for _, n := range []int{0, -1} {
+		if _, err := GenerateOTP(n); !errors.Is(err, ErrInvalidLength) {
+			t.Errorf("GenerateOTP(%d) err = %v", n, err)
+		}
+		if _, err := GenerateToken(n); !errors.Is(err, ErrInvalidLength) {
+			t.Errorf("GenerateToken(%d) err = %v", n, err)
+		}
+		if _, err := GenerateHexToken(n); !errors.Is(err, ErrInvalidLength) {
+			t.Errorf("GenerateHexToken(%d) err = %v", n, err)
+		}
+		if _, err := GenerateAPIKey("sk", n); !errors.Is(err, ErrInvalidLength) {
+			t.Errorf("GenerateAPIKey(%d) err = %v", n, err)
+		}
+	}
+}
+
+func TestGenerateToken(t *testing.T) {
+	for _, n := range []int{1, 2, 3, 16, 32, 33} {
+		token, err := GenerateToken(n)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if strings.ContainsAny(token, "+/=") {
+			t.Fatalf("GenerateToken(%d) = %q is not URL-safe and unpadded", n, token)
+		}
+		raw, err := base64.RawURLEncoding.DecodeString(token)
+		if err != nil {
+			t.Fatalf("GenerateToken(%d) = %q: %v", n, token, err)
+		}
+		if len(raw) != n {
+			t.Fatalf("GenerateToken(%d) decodes to %d bytes", n, len(raw))
+		}
+
+		hexToken, err := GenerateHexToken(n)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if raw, err := hex.DecodeString(hexToken); err != nil || len(raw) != n {
+			t.Fatalf("GenerateHexToken(%d) = %q decodes to %d bytes, %v", n, hexToken, len(raw), err)
+		}
+	}
+
+	a, _ := GenerateToken(16)
+	b, _ := GenerateToken(16)
+	if a == b {
+		t.Fatal("two tokens are equal")
+	}
+}
+
+func TestGenerateAPIKey(t *testing.T) {
+	tests := []struct {
+		prefix string
+		want   string
+	}{
+		{"sk_live", "sk_live_"},
+		{"sk_live_", "sk_live_"},
+		{"pk", "pk_"},
+		{"", ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.prefix, func(t *testing.T) {
+			key, err := GenerateAPIKey(tt.prefix, 24)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !strings.HasPrefix(key, tt.want) {
+				t.Fatalf("key %q does not start with %q", key, tt.want)
+			}
+			raw, err := base64.RawURLEncoding.DecodeString(strings.TrimPrefix(key, tt.want))
+			if err != nil || len(raw) != 24 {
+				t.Fatalf("token part of %q decodes to %d bytes, %v", key, len(raw), err)
+			}
+		})
+	}
+}
@@ -0,0 +1,179 @@
+package formattools
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"unicode"
+)
+
+var currencySymbols = map[string]string{
+	"THB": "฿",
+	"USD": "$",
+	"EUR": "€",
+	"GBP": "£",
+	"JPY": "¥",
+}
+
+// Minor unit digits for currencies that don't use the default of 2.
+var currencyPrecisions = map[string]int{
+	"JPY": 0,
+	"KRW": 0,
+	"VND": 0,
+	"BHD": 3,
+	"KWD": 3,
+	"OMR": 3,
+}
+
+// Declare Currency Amount, Amount is in minor units (e.g. satang, cents)
+type CurrencyAmount struct {
+	Amount    int64
+	Currency  string
+	Precision int
+	// Separators default to "," and "." when empty.
+	GroupSeparator   string
+	DecimalSeparator string
+	// Prefix with the currency symbol (฿1,234.56) instead of the code (USD 1,234.56).
+	UseSymbol bool
+}
+
+// Generate New Currency Amount, the precision comes from the currency and the
+// symbol is used when one is known.
+func NewCurrencyAmount(minor int64, currency string) *CurrencyAmount {
+	currency = strings.ToUpper(currency)
+	_, hasSymbol := currencySymbols[currency]
+	return &CurrencyAmount{
+		Amount:    minor,
+		Currency:  currency,
+		Precision: currencyPrecision(currency),
+		UseSymbol: hasSymbol,
+	}
+}
+
+func currencyPrecision(currency string) int {
+	if p, ok := currencyPrecisions[currency]; ok {
+		return p
+	}
+	return 2
+}
+
+// Currency Amount Pattern. A negative Precision is treated as 0.
+func (c CurrencyAmount) String() string {
+	group, decimal := c.GroupSeparator, c.DecimalSeparator
+	if group == "" {
+		group = ","
+	}
+	if decimal == "" {
+		decimal = "."
+	}
+
+	precision := c.Precision
+	if precision < 0 {
+		precision = 0
+	}
+
+	// Unsigned negation so math.MinInt64 doesn't overflow.
+	amount := uint64(c.Amount)
+	sign := ""
+	if c.Amount < 0 {
+		sign = "-"
+		amount = -amount
+	}
+
+	digits := strconv.FormatUint(amount, 10)
+	if len(digits) <= precision {
+		digits = strings.Repeat("0", precision-len(digits)+1) + digits
+	}
+	whole, fraction := digits[:len(digits)-precision], digits[len(digits)-precision:]
+
+	var sb strings.Builder
+	for i, r := range whole {
+		if i > 0 && (len(whole)-i)%3 == 0 {
+			sb.WriteString(group)
+		}
+		sb.WriteRune(r)
+	}
+	number := sb.String()
+	if precision > 0 {
+		number += decimal + fraction
+	}
+
+	if symbol, ok := currencySymbols[c.Currency]; ok && c.UseSymbol {
+		return sign + symbol + number
+	}
+	if c.Currency == "" {
+		return sign + number
+	}
+	return sign + c.Currency + " " + number
+}
+
+// ParseCurrencyAmount reads the output of String with the default separators,
+// e.g. "฿1,234.56", "USD 1,234.56" or "-$5.00".
+func ParseCurrencyAmount(s string) (CurrencyAmount, error) {
+	invalid := fmt.Errorf("formattools: invalid currency amount %q", s)
+
+	s = strings.TrimSpace(s)
+	negative := strings.HasPrefix(s, "-")
+	s = strings.TrimPrefix(s, "-")
+
+	currency, useSymbol := "", false
+	for code, symbol := range currencySymbols {
+		if strings.HasPrefix(s, symbol) {
+			currency, useSymbol = code, true
+			s = strings.TrimPrefix(s, symbol)
+			break
+		}
+	}
+	if currency == "" {
+		i := strings.IndexFunc(s, func(r rune) bool { return !unicode.IsLetter(r) })
+		if i > 0 {
+			currency = strings.ToUpper(s[:i])
+			s = s[i:]
+		}
+	}
+	s = strings.TrimSpace(strings.ReplaceAll(s, ",", ""))
+	if s == "" {
+		return CurrencyAmount{}, invalid
+	}
+
+	whole, fraction, _ := strings.Cut(s, ".")
+	precision := len(fraction)
+	if currency != "" {
+		precision = currencyPrecision(currency)
+		if len(fraction) > precision {
+			return CurrencyAmount{}, invalid
+		}
+		fraction += strings.Repeat("0", precision-len(fraction))
+	}
+	if whole == "" {
+		whole = "0"
+	}
+	// strconv accepts a leading sign, only "-" before the currency is allowed.
+	for _, r := range whole + fraction {
+		if r < '0' || r > '9' {
+			return CurrencyAmount{}, invalid
+		}
+	}
+
+	magnitude, err := strconv.ParseUint(whole+fraction, 10, 64)
+	if err != nil {
+		return CurrencyAmount{}, invalid
+	}
+	var amount int64
+	switch {
+	case negative && magnitude <= 1<<63:
+		// Negated as uint64 so -9223372036854775808 round trips.
+		amount = int64(-magnitude)
+	case !negative && magnitude < 1<<63:
+		amount = int64(magnitude)
+	default:
+		return CurrencyAmount{}, invalid
+	}
+
+	return CurrencyAmount{
+		Amount:    amount,
+		Currency:  currency,
+		Precision: precision,
+		UseSymbol: useSymbol,
+	}, nil
+}
@@ -0,0 +1,77 @@
+package formattools
+
+import (
+	"math"
+	"testing"
+)
+
+func TestCurrencyAmountString(t *testing.T) {
+	tests := []struct {
+		name   string
+		amount CurrencyAmount
+		want   string
+	}{
+		{"symbol", *NewCurrencyAmount(123456, "THB"), "฿1,234.56"},
+		{"code", *NewCurrencyAmount(123456, "CHF"), "CHF 1,234.56"},
+		{"negative", *NewCurrencyAmount(-500, "USD"), "-$5.00"},
+		{"zero precision", *NewCurrencyAmount(1500, "JPY"), "¥1,500"},
+		{"three digit precision", *NewCurrencyAmount(1, "KWD"), "KWD 0.001"},
+		{"min int64", CurrencyAmount{Amount: math.MinInt64, Currency: "USD", Precision: 2}, "-USD 92,233,720,368,547,758.08"},
+		{"max int64", CurrencyAmount{Amount: math.MaxInt64, Currency: "USD", Precision: 2}, "USD 92,233,720,368,547,758.07"},
+		{"negative precision", CurrencyAmount{Amount: 1234, Currency: "USD", Precision: -2}, "USD 1,234"},
+		{"custom separators", CurrencyAmount{Amount: 123456, Currency: "EUR", Precision: 2, GroupSeparator: ".", DecimalSeparator: ","}, "EUR 1.234,56"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.amount.String(); got != tt.want {
+				t.Fatalf("String = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestParseCurrencyAmount(t *testing.T) {
+	tests := []struct {
+		in       string
+		amount   int64
+		currency string
+	}{
+		{"฿1,234.56", 123456, "THB"},
+		{"USD 1,234.56", 123456, "USD"},
+		{"-$5.00", -500, "USD"},
+		{"¥1,500", 1500, "JPY"},
+		{"-USD 92,233,720,368,547,758.08", math.MinInt64, "USD"},
+		{"USD 92,233,720,368,547,758.07", math.MaxInt64, "USD"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.in, func(t *testing.T) {
+			got, err := ParseCurrencyAmount(tt.in)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got.Amount != tt.amount || got.Currency != tt.currency {
+				t.Fatalf("got %d %s, want %d %s", got.Amount, got.Currency, tt.amount, tt.currency)
+			}
+		})
+	}
+}
+
+func TestParseCurrencyAmountRejects(t *testing.T) {
+	for _, in := range []string{
+		"EUR +5",
+		"+$5.00",
+		"USD -5",
+		"USD 5.-3",
+		"USD 1.234",
+		"USD 92,233,720,368,547,758.08",
+		"-USD 92,233,720,368,547,758.09",
+		"USD",
+		"",
+	} {
+		if _, err := ParseCurrencyAmount(in); err == nil {
+			t.Errorf("ParseCurrencyAmount(%q) should fail", in)
+		}
+	}
+}
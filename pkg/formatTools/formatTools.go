@@ -1,6 +1,17 @@
 package formattools
 
-import "fmt"
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+var (
+	ErrValueTooLarge   = errors.New("formattools: value does not fit in the segment widths")
+	ErrInvalidFormat   = errors.New("formattools: value does not match the format")
+	ErrInvalidSegments = errors.New("formattools: segment widths must be positive and total at most 20 digits")
+)
 
 // Declare Medical Number
 type MedicalNo struct {
@@ -21,3 +32,86 @@ func (mr MedicalNo) String() string {
 
 	return medicalNo
 }
+
+// Declare configurable Medical Number format
+type MedicalNoFormat struct {
+	segments  []int
+	separator string
+	digits    int
+}
+
+// Generate New Medical Number format, segments is the digit count per group.
+// NewMedicalNoFormat([]int{3, 2, 2}, "-") gives the MedicalNo pattern NNN-NN-NN.
+// Every width must be at least 1 and the total can't exceed the 20 digits of a uint64.
+func NewMedicalNoFormat(segments []int, separator string) (*MedicalNoFormat, error) {
+	if len(segments) == 0 {
+		return nil, ErrInvalidSegments
+	}
+	digits := 0
+	for _, s := range segments {
+		if s < 1 {
+			return nil, ErrInvalidSegments
+		}
+		digits += s
+	}
+	if digits > 20 {
+		return nil, ErrInvalidSegments
+	}
+	if strings.ContainsAny(separator, "0123456789") {
+		return nil, errors.New("formattools: separator must not contain digits")
+	}
+	return &MedicalNoFormat{
+		segments:  append([]int(nil), segments...),
+		separator: separator,
+		digits:    digits,
+	}, nil
+}
+
+// Format zero-pads the value to the total width and splits it into the segments.
+func (f *MedicalNoFormat) Format(value uint64) (string, error) {
+	digits := strconv.FormatUint(value, 10)
+	if len(digits) > f.digits {
+		return "", ErrValueTooLarge
+	}
+	digits = strings.Repeat("0", f.digits-len(digits)) + digits
+
+	groups := make([]string, 0, len(f.segments))
+	pos := 0
+	for _, s := range f.segments {
+		groups = append(groups, digits[pos:pos+s])
+		pos += s
+	}
+	return strings.Join(groups, f.separator), nil
+}
+
+// Parse reverses Format back to the number. With a separator every group must
+// have exactly its segment width, so "12-345-67" is rejected for {3, 2, 2}.
+func (f *MedicalNoFormat) Parse(s string) (uint64, error) {
+	digits := s
+	if f.separator != "" {
+		groups := strings.Split(s, f.separator)
+		if len(groups) != len(f.segments) {
+			return 0, ErrInvalidFormat
+		}
+		for i, g := range groups {
+			if len(g) != f.segments[i] {
+				return 0, ErrInvalidFormat
+			}
+		}
+		digits = strings.Join(groups, "")
+	}
+	if len(digits) != f.digits {
+		return 0, ErrInvalidFormat
+	}
+	for _, r := range digits {
+		if r < '0' || r > '9' {
+			return 0, ErrInvalidFormat
+		}
+	}
+
+	value, err := strconv.ParseUint(digits, 10, 64)
+	if err != nil {
+		return 0, ErrValueTooLarge
+	}
+	return value, nil
+}
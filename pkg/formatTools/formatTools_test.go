@@ -0,0 +1,81 @@
+package formattools
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestMedicalNoFormatRoundTrip(t *testing.T) {
+	f, err := NewMedicalNoFormat([]int{3, 2, 2}, "-")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	s, err := f.Format(1234567)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if s != "123-45-67" {
+		t.Fatalf("Format = %q", s)
+	}
+	if v, err := f.Parse(s); err != nil || v != 1234567 {
+		t.Fatalf("Parse = %d, %v", v, err)
+	}
+	if s, _ := f.Format(42); s != "000-00-42" {
+		t.Fatalf("Format(42) = %q", s)
+	}
+	if _, err := f.Format(12345678); !errors.Is(err, ErrValueTooLarge) {
+		t.Fatalf("Format too large err = %v", err)
+	}
+}
+
+func TestMedicalNoFormatParseChecksGroups(t *testing.T) {
+	f, err := NewMedicalNoFormat([]int{3, 2, 2}, "-")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, s := range []string{
+		"12-345-67",
+		"1234-5-67",
+		"1234567",
+		"123-4567",
+		"123-45-67-",
+		"123-45-6a",
+		"",
+	} {
+		if _, err := f.Parse(s); !errors.Is(err, ErrInvalidFormat) {
+			t.Errorf("Parse(%q) err = %v, want ErrInvalidFormat", s, err)
+		}
+	}
+
+	plain, err := NewMedicalNoFormat([]int{3, 4}, "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if v, err := plain.Parse("0001234"); err != nil || v != 1234 {
+		t.Fatalf("Parse without separator = %d, %v", v, err)
+	}
+}
+
+func TestNewMedicalNoFormatRejectsInvalidSegments(t *testing.T) {
+	tests := []struct {
+		name      string
+		segments  []int
+		separator string
+	}{
+		{"no segments", nil, "-"},
+		{"zero width", []int{3, 0, 2}, "-"},
+		{"negative width", []int{3, -2, 2}, "-"},
+		{"wider than uint64", []int{10, 11}, "-"},
+		{"digit separator", []int{3, 2}, "0"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := NewMedicalNoFormat(tt.segments, tt.separator); err == nil {
+				t.Fatal("expected an error")
+			}
+		})
+	}
+}
@@ -0,0 +1,129 @@
+package formattools
+
+import (
+	"fmt"
+	"strings"
+	"unicode"
+)
+
+const (
+	slotDigit        = 'N'
+	slotAlpha        = 'A'
+	slotAlphanumeric = 'X'
+)
+
+type patternToken struct {
+	slot    rune
+	literal rune
+}
+
+// Declare Formatter, a pattern of N (digit), A (letter), X (letter or digit)
+// slots and literal characters. Use a backslash to make N, A or X literal, e.g.
+// `I\NV-NNNN` for "INV-1234".
+type Formatter struct {
+	pattern  string
+	tokens   []patternToken
+	slots    int
+	literals map[rune]struct{}
+}
+
+// Generate New Formatter, e.g. NewFormatter("NNN-NN-NN") for medical numbers
+// or NewFormatter("AAAA-NNNN") for product codes.
+func NewFormatter(pattern string) *Formatter {
+	f := &Formatter{pattern: pattern, literals: map[rune]struct{}{}}
+
+	escaped := false
+	for _, r := range pattern {
+		switch {
+		case escaped:
+			f.tokens = append(f.tokens, patternToken{literal: r})
+			f.literals[r] = struct{}{}
+			escaped = false
+		case r == '\\':
+			escaped = true
+		case r == slotDigit || r == slotAlpha || r == slotAlphanumeric:
+			f.tokens = append(f.tokens, patternToken{slot: r})
+			f.slots++
+		default:
+			f.tokens = append(f.tokens, patternToken{literal: r})
+			f.literals[r] = struct{}{}
+		}
+	}
+	return f
+}
+
+// Format fills the slots with value and inserts the literal characters. value
+// may also be already formatted, it is passed through Unformat first.
+func (f *Formatter) Format(value string) (string, error) {
+	chars := []rune(f.Unformat(value))
+	if len(chars) != f.slots {
+		return "", fmt.Errorf("%w: %q needs %d characters for pattern %q", ErrInvalidFormat, value, f.slots, f.pattern)
+	}
+
+	var sb strings.Builder
+	i := 0
+	for _, t := range f.tokens {
+		if t.slot == 0 {
+			sb.WriteRune(t.literal)
+			continue
+		}
+		c := chars[i]
+		if !matchesSlot(t.slot, c) {
+			return "", fmt.Errorf("%w: %q at position %d must be %s", ErrInvalidFormat, c, i, slotName(t.slot))
+		}
+		sb.WriteRune(c)
+		i++
+	}
+	return sb.String(), nil
+}
+
+// Unformat strips the pattern's literal characters. A fully formatted string
+// is stripped by position, so literal letters (e.g. "INV") are removed safely;
+// any other input only loses literals that are not letters or digits.
+func (f *Formatter) Unformat(s string) string {
+	runes := []rune(s)
+	if len(runes) == len(f.tokens) {
+		var sb strings.Builder
+		positional := true
+		for i, t := range f.tokens {
+			if t.slot == 0 {
+				if runes[i] != t.literal {
+					positional = false
+					break
+				}
+				continue
+			}
+			sb.WriteRune(runes[i])
+		}
+		if positional {
+			return sb.String()
+		}
+	}
+
+	return strings.Map(func(r rune) rune {
+		if _, ok := f.literals[r]; ok && !unicode.IsLetter(r) && !unicode.IsDigit(r) {
+			return -1
+		}
+		return r
+	}, s)
+}
+
+func matchesSlot(slot, c rune) bool {
+	switch slot {
+	case slotDigit:
+		return c >= '0' && c <= '9'
+	case slotAlpha:
+		return unicode.IsLetter(c)
+	}
+	return unicode.IsLetter(c) || (c >= '0' && c <= '9')
+}
+
+func slotName(slot rune) string {
+	switch slot {
+	case slotDigit:
+		return "a digit"
+	case slotAlpha:
+		return "a letter"
+	}
+	return "alphanumeric"
+}
@@ -0,0 +1,94 @@
+package formattools
+
+import (
+	"errors"
+	"strings"
+	"testing"
+)
+
+func TestFormatterFormat(t *testing.T) {
+	tests := []struct {
+		pattern string
+		in      string
+		want    string
+	}{
+		{"NNN-NN-NN", "1234567", "123-45-67"},
+		{"NNN-NN-NN", "123-45-67", "123-45-67"},
+		{"AAAA-NNNN", "ABCD1234", "ABCD-1234"},
+		{"AAAA-NNNN", "abcd-1234", "abcd-1234"},
+		{"AAAA-NNNN", "ABCD-1234", "ABCD-1234"},
+		{"XX/XX", "a1b2", "a1/b2"},
+		{`I\NV-NNNN`, "1234", "INV-1234"},
+		{`I\NV-NNNN`, "INV-1234", "INV-1234"},
+		{`\A\\NN`, "12", `A\12`},
+		{"AA-NN", "กข12", "กข-12"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.pattern+" "+tt.in, func(t *testing.T) {
+			got, err := NewFormatter(tt.pattern).Format(tt.in)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got != tt.want {
+				t.Fatalf("Format = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestFormatterFormatErrors(t *testing.T) {
+	tests := []struct {
+		pattern string
+		in      string
+		wantErr string
+	}{
+		{"NNN-NN-NN", "123456", "needs 7 characters"},
+		{"NNN-NN-NN", "12345678", "needs 7 characters"},
+		{"NNN-NN-NN", "", "needs 7 characters"},
+		{"NNN-NN-NN", "12345a7", `'a' at position 5 must be a digit`},
+		{"AAAA-NNNN", "AB1D1234", `'1' at position 2 must be a letter`},
+		{"XX/XX", "a1b-", `'-' at position 3 must be alphanumeric`},
+		// Without escaping, the N in "INV" is a digit slot.
+		{"INV-NNNN", "INV-1234", `'N' at position 0 must be a digit`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.pattern+" "+tt.in, func(t *testing.T) {
+			_, err := NewFormatter(tt.pattern).Format(tt.in)
+			if !errors.Is(err, ErrInvalidFormat) {
+				t.Fatalf("err = %v, want ErrInvalidFormat", err)
+			}
+			if !strings.Contains(err.Error(), tt.wantErr) {
+				t.Fatalf("err = %q, want it to contain %q", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestFormatterUnformat(t *testing.T) {
+	tests := []struct {
+		pattern string
+		in      string
+		want    string
+	}{
+		// Positional: the input lines up with the pattern, literal letters are dropped too.
+		{`I\NV-NNNN`, "INV-1234", "1234"},
+		{"NNN-NN-NN", "123-45-67", "1234567"},
+		{`\A\X-XX`, "AX-B2", "B2"},
+		// Free-form: only non-alphanumeric literals are removed.
+		{`I\NV-NNNN`, "INV 1234", "INV 1234"},
+		{`I\NV-NNNN`, "IN-V1234", "INV1234"},
+		{"NNN-NN-NN", "12-345-67", "1234567"},
+		{"NNN-NN-NN", "1234567", "1234567"},
+		{"AAAA-NNNN", "ab-cd 12", "abcd 12"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.pattern+" "+tt.in, func(t *testing.T) {
+			if got := NewFormatter(tt.pattern).Unformat(tt.in); got != tt.want {
+				t.Fatalf("Unformat = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
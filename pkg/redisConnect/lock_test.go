@@ -0,0 +1,132 @@
+package redisconnect
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/alicebob/miniredis/v2"
+	"github.com/redis/go-redis/v9"
+)
+
+func newTestRedis(t *testing.T) (*miniredis.Miniredis, *redis.Client) {
+	t.Helper()
+	mr := miniredis.RunT(t)
+	client := redis.NewClient(&redis.Options{Addr: mr.Addr()})
+	t.Cleanup(func() { client.Close() })
+	return mr, client
+}
+
+func TestRedisLockAcquireRelease(t *testing.T) {
+	mr, client := newTestRedis(t)
+	ctx := context.Background()
+
+	a := NewRedisLock(client, "lock:job", time.Second)
+	b := NewRedisLock(client, "lock:job", time.Second)
+
+	ok, err := a.Acquire(ctx)
+	if err != nil || !ok {
+		t.Fatalf("first Acquire = %v, %v", ok, err)
+	}
+	if ttl := mr.TTL("lock:job"); ttl != time.Second {
+		t.Fatalf("TTL = %s, want 1s", ttl)
+	}
+
+	ok, err = b.Acquire(ctx)
+	if err != nil || ok {
+		t.Fatalf("second Acquire = %v, %v, want false", ok, err)
+	}
+
+	if err := b.Release(ctx); !errors.Is(err, ErrLockNotHeld) {
+		t.Fatalf("Release by non-holder = %v, want ErrLockNotHeld", err)
+	}
+	if !mr.Exists("lock:job") {
+		t.Fatal("non-holder release deleted the lock")
+	}
+
+	if err := a.Release(ctx); err != nil {
+		t.Fatalf("Release by holder = %v", err)
+	}
+	if mr.Exists("lock:job") {
+		t.Fatal("lock still exists after release")
+	}
+}
+
+func TestRedisLockExpiry(t *testing.T) {
+	mr, client := newTestRedis(t)
+	ctx := context.Background()
+
+	a := NewRedisLock(client, "lock:job", time.Second)
+	if ok, _ := a.Acquire(ctx); !ok {
+		t.Fatal("Acquire failed")
+	}
+	mr.FastForward(2 * time.Second)
+
+	b := NewRedisLock(client, "lock:job", time.Second)
+	if ok, _ := b.Acquire(ctx); !ok {
+		t.Fatal("expected the expired lock to be free")
+	}
+	if err := a.Release(ctx); !errors.Is(err, ErrLockNotHeld) {
+		t.Fatalf("Release after expiry = %v, want ErrLockNotHeld", err)
+	}
+}
+
+func TestRedisLockExtend(t *testing.T) {
+	mr, client := newTestRedis(t)
+	ctx := context.Background()
+
+	a := NewRedisLock(client, "lock:job", time.Second)
+	if ok, _ := a.Acquire(ctx); !ok {
+		t.Fatal("Acquire failed")
+	}
+	if err := a.Extend(ctx, 5*time.Second); err != nil {
+		t.Fatal(err)
+	}
+	if ttl := mr.TTL("lock:job"); ttl != 5*time.Second {
+		t.Fatalf("TTL = %s, want 5s", ttl)
+	}
+
+	b := NewRedisLock(client, "lock:job", time.Second)
+	if err := b.Extend(ctx, time.Minute); !errors.Is(err, ErrLockNotHeld) {
+		t.Fatalf("Extend by non-holder = %v, want ErrLockNotHeld", err)
+	}
+}
+
+func TestRedisLockTryAcquireWithRetry(t *testing.T) {
+	_, client := newTestRedis(t)
+
+	holder := NewRedisLock(client, "lock:job", time.Minute)
+	if ok, _ := holder.Acquire(context.Background()); !ok {
+		t.Fatal("Acquire failed")
+	}
+
+	waiter := NewRedisLock(client, "lock:job", time.Minute)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	if err := waiter.TryAcquireWithRetry(ctx, 10*time.Millisecond); !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("TryAcquireWithRetry on held lock = %v, want deadline exceeded", err)
+	}
+
+	go func() {
+		time.Sleep(20 * time.Millisecond)
+		holder.Release(context.Background())
+	}()
+	ctx, cancel = context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+	if err := waiter.TryAcquireWithRetry(ctx, 5*time.Millisecond); err != nil {
+		t.Fatalf("TryAcquireWithRetry after release = %v", err)
+	}
+}
+
+func TestRedisLockTryAcquireWithRetryInvalidInterval(t *testing.T) {
+	_, client := newTestRedis(t)
+	lock := NewRedisLock(client, "lock:job", time.Second)
+
+	for _, interval := range []time.Duration{0, -time.Second} {
+		if err := lock.TryAcquireWithRetry(context.Background(), interval); err == nil {
+			t.Fatalf("interval %s: expected an error", interval)
+		}
+	}
+}
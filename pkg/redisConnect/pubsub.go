@@ -0,0 +1,81 @@
+package redisconnect
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/redis/go-redis/v9"
+)
+
+type PubSub struct {
+	client *redis.Client
+}
+
+func NewPubSub(client *redis.Client) *PubSub {
+	return &PubSub{client: client}
+}
+
+// Publish sends payload to channel as JSON.
+func (ps *PubSub) Publish(ctx context.Context, channel string, payload interface{}) error {
+	data, err := json.Marshal(payload)
+	if err != nil {
+		return err
+	}
+	return ps.client.Publish(ctx, channel, data).Err()
+}
+
+// Subscribe returns once the subscription is confirmed. Messages are then
+// dispatched in the background, each handler call in its own goroutine, until
+// ctx is cancelled.
+func (ps *PubSub) Subscribe(ctx context.Context, channel string, handler func(msg *redis.Message)) error {
+	sub := ps.client.Subscribe(ctx, channel)
+	if _, err := sub.Receive(ctx); err != nil {
+		sub.Close()
+		return err
+	}
+
+	go func() {
+		defer sub.Close()
+		messages := sub.Channel()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case msg, ok := <-messages:
+				if !ok {
+					return
+				}
+				go handler(msg)
+			}
+		}
+	}()
+	return nil
+}
+
+// TypedPubSub encodes and decodes messages of type T as JSON.
+type TypedPubSub[T any] struct {
+	pubsub *PubSub
+	// Called when a message cannot be decoded into T. When nil such messages are dropped.
+	OnDecodeError func(msg *redis.Message, err error)
+}
+
+func NewTypedPubSub[T any](client *redis.Client) *TypedPubSub[T] {
+	return &TypedPubSub[T]{pubsub: NewPubSub(client)}
+}
+
+func (tps *TypedPubSub[T]) Publish(ctx context.Context, channel string, payload T) error {
+	return tps.pubsub.Publish(ctx, channel, payload)
+}
+
+func (tps *TypedPubSub[T]) Subscribe(ctx context.Context, channel string, handler func(payload T)) error {
+	return tps.pubsub.Subscribe(ctx, channel, func(msg *redis.Message) {
+		var payload T
+		if err := json.Unmarshal([]byte(msg.Payload), &payload); err != nil {
+			if tps.OnDecodeError != nil {
+				tps.OnDecodeError(msg, err)
+			}
+			return
+		}
+		handler(payload)
+	})
+}
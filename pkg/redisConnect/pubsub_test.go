@@ -0,0 +1,137 @@
+package redisconnect
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+)
+
+type pubsubEvent struct {
+	ID   int64  `json:"id"`
+	Kind string `json:"kind"`
+}
+
+func receive[T any](t *testing.T, ch <-chan T) T {
+	t.Helper()
+	select {
+	case v := <-ch:
+		return v
+	case <-time.After(2 * time.Second):
+		t.Fatal("timed out waiting for a message")
+		panic("unreachable")
+	}
+}
+
+func TestTypedPubSubRoundTrip(t *testing.T) {
+	_, client := newTestRedis(t)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	tps := NewTypedPubSub[pubsubEvent](client)
+	got := make(chan pubsubEvent, 1)
+	if err := tps.Subscribe(ctx, "events", func(e pubsubEvent) { got <- e }); err != nil {
+		t.Fatal(err)
+	}
+
+	want := pubsubEvent{ID: 9007199254740993, Kind: "created"}
+	if err := tps.Publish(ctx, "events", want); err != nil {
+		t.Fatal(err)
+	}
+	if e := receive(t, got); e != want {
+		t.Fatalf("got %+v, want %+v", e, want)
+	}
+}
+
+func TestTypedPubSubDecodeError(t *testing.T) {
+	_, client := newTestRedis(t)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	tps := NewTypedPubSub[pubsubEvent](client)
+	decodeErrs := make(chan string, 1)
+	tps.OnDecodeError = func(msg *redis.Message, err error) {
+		if err == nil {
+			t.Error("OnDecodeError called with a nil error")
+		}
+		decodeErrs <- msg.Payload
+	}
+	got := make(chan pubsubEvent, 1)
+	if err := tps.Subscribe(ctx, "events", func(e pubsubEvent) { got <- e }); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := client.Publish(ctx, "events", `{"id":"not a number"}`).Err(); err != nil {
+		t.Fatal(err)
+	}
+	if payload := receive(t, decodeErrs); payload != `{"id":"not a number"}` {
+		t.Fatalf("OnDecodeError payload = %q", payload)
+	}
+	select {
+	case e := <-got:
+		t.Fatalf("handler called for an undecodable message: %+v", e)
+	default:
+	}
+}
+
+func TestTypedPubSubDropsUndecodable(t *testing.T) {
+	_, client := newTestRedis(t)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	tps := NewTypedPubSub[pubsubEvent](client)
+	got := make(chan pubsubEvent, 2)
+	if err := tps.Subscribe(ctx, "events", func(e pubsubEvent) { got <- e }); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := client.Publish(ctx, "events", "not json").Err(); err != nil {
+		t.Fatal(err)
+	}
+	want := pubsubEvent{ID: 1, Kind: "after"}
+	if err := tps.Publish(ctx, "events", want); err != nil {
+		t.Fatal(err)
+	}
+
+	if e := receive(t, got); e != want {
+		t.Fatalf("got %+v, want %+v", e, want)
+	}
+	select {
+	case e := <-got:
+		t.Fatalf("unexpected extra message %+v", e)
+	case <-time.After(50 * time.Millisecond):
+	}
+}
+
+func TestPubSubUnsubscribesOnCancel(t *testing.T) {
+	mr, client := newTestRedis(t)
+	ctx, cancel := context.WithCancel(context.Background())
+
+	ps := NewPubSub(client)
+	if err := ps.Subscribe(ctx, "events", func(*redis.Message) {}); err != nil {
+		t.Fatal(err)
+	}
+	if n := mr.PubSubNumSub("events")["events"]; n != 1 {
+		t.Fatalf("subscribers = %d, want 1", n)
+	}
+
+	cancel()
+	deadline := time.Now().Add(2 * time.Second)
+	for mr.PubSubNumSub("events")["events"] != 0 {
+		if time.Now().After(deadline) {
+			t.Fatal("subscription still open after ctx was cancelled")
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+}
+
+func TestPubSubSubscribeCancelledContext(t *testing.T) {
+	_, client := newTestRedis(t)
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	if err := NewPubSub(client).Subscribe(ctx, "events", func(*redis.Message) {}); err == nil {
+		t.Fatal("expected an error subscribing with a cancelled context")
+	}
+}
@@ -0,0 +1,114 @@
+package redisconnect
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+)
+
+// KEYS[1] = window key, ARGV = now (ms), window (ms), max requests, member.
+// Returns {allowed, retry_after_ms}.
+var slidingWindowAllowScript = redis.NewScript(`
+local key = KEYS[1]
+local now = tonumber(ARGV[1])
+local window = tonumber(ARGV[2])
+local max = tonumber(ARGV[3])
+
+redis.call("ZREMRANGEBYSCORE", key, 0, now - window)
+local count = redis.call("ZCARD", key)
+if count < max then
+	redis.call("ZADD", key, now, ARGV[4])
+	redis.call("PEXPIRE", key, window)
+	return {1, 0}
+end
+
+local oldest = redis.call("ZRANGE", key, 0, 0, "WITHSCORES")
+local retry = 0
+if oldest[2] then
+	retry = tonumber(oldest[2]) + window - now
+end
+return {0, retry}
+`)
+
+// KEYS[1] = window key, ARGV = now (ms), window (ms). Returns {count, oldest_ms}.
+var slidingWindowStatusScript = redis.NewScript(`
+local key = KEYS[1]
+local now = tonumber(ARGV[1])
+local window = tonumber(ARGV[2])
+
+redis.call("ZREMRANGEBYSCORE", key, 0, now - window)
+local count = redis.call("ZCARD", key)
+local oldest = redis.call("ZRANGE", key, 0, 0, "WITHSCORES")
+if oldest[2] then
+	return {count, tonumber(oldest[2])}
+end
+return {count, 0}
+`)
+
+type RateLimitStatus struct {
+	Remaining int
+	Total     int
+	ResetsAt  time.Time
+}
+
+// SlidingWindowRateLimiter allows at most maxRequests per key within any
+// window of windowDuration. Each key is a sorted set of request timestamps.
+type SlidingWindowRateLimiter struct {
+	client         *redis.Client
+	windowDuration time.Duration
+	maxRequests    int
+}
+
+func NewSlidingWindowRateLimiter(client *redis.Client, windowDuration time.Duration, maxRequests int) *SlidingWindowRateLimiter {
+	return &SlidingWindowRateLimiter{
+		client:         client,
+		windowDuration: windowDuration,
+		maxRequests:    maxRequests,
+	}
+}
+
+// Allow records the request when it fits in the window. When it doesn't,
+// the returned duration is how long until the oldest request leaves the window.
+func (rl *SlidingWindowRateLimiter) Allow(ctx context.Context, key string) (bool, time.Duration, error) {
+	now := time.Now()
+	member := fmt.Sprintf("%d-%s", now.UnixNano(), newLockToken()[:8])
+
+	res, err := slidingWindowAllowScript.Run(ctx, rl.client, []string{key},
+		now.UnixMilli(), rl.windowDuration.Milliseconds(), rl.maxRequests, member).Int64Slice()
+	if err != nil {
+		return false, 0, err
+	}
+	if len(res) != 2 {
+		return false, 0, fmt.Errorf("rate limiter: unexpected script result %v", res)
+	}
+
+	return res[0] == 1, time.Duration(res[1]) * time.Millisecond, nil
+}
+
+// Status reads the current window without recording a request.
+func (rl *SlidingWindowRateLimiter) Status(ctx context.Context, key string) (RateLimitStatus, error) {
+	now := time.Now()
+	res, err := slidingWindowStatusScript.Run(ctx, rl.client, []string{key},
+		now.UnixMilli(), rl.windowDuration.Milliseconds()).Int64Slice()
+	if err != nil {
+		return RateLimitStatus{}, err
+	}
+	if len(res) != 2 {
+		return RateLimitStatus{}, fmt.Errorf("rate limiter: unexpected script result %v", res)
+	}
+
+	status := RateLimitStatus{
+		Remaining: rl.maxRequests - int(res[0]),
+		Total:     rl.maxRequests,
+		ResetsAt:  now,
+	}
+	if status.Remaining < 0 {
+		status.Remaining = 0
+	}
+	if res[0] > 0 {
+		status.ResetsAt = time.UnixMilli(res[1]).Add(rl.windowDuration)
+	}
+	return status, nil
+}
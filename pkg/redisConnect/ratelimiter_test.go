@@ -0,0 +1,104 @@
+package redisconnect
+
+import (
+	"context"
+	"testing"
+	"time"
+)
+
+func TestSlidingWindowRateLimiter(t *testing.T) {
+	_, client := newTestRedis(t)
+	ctx := context.Background()
+
+	const window = 300 * time.Millisecond
+	rl := NewSlidingWindowRateLimiter(client, window, 3)
+
+	for i := 0; i < 3; i++ {
+		ok, retry, err := rl.Allow(ctx, "rl:user")
+		if err != nil || !ok || retry != 0 {
+			t.Fatalf("request %d = %v, %s, %v, want allowed", i+1, ok, retry, err)
+		}
+	}
+
+	ok, retry, err := rl.Allow(ctx, "rl:user")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if ok {
+		t.Fatal("request over the limit was allowed")
+	}
+	if retry <= 0 || retry > window {
+		t.Fatalf("retry after = %s, want within (0, %s]", retry, window)
+	}
+
+	if ok, _, err := rl.Allow(ctx, "rl:other"); err != nil || !ok {
+		t.Fatalf("other key = %v, %v, want allowed", ok, err)
+	}
+
+	time.Sleep(retry + 20*time.Millisecond)
+	if ok, _, err := rl.Allow(ctx, "rl:user"); err != nil || !ok {
+		t.Fatalf("after the window = %v, %v, want allowed", ok, err)
+	}
+}
+
+func TestSlidingWindowRateLimiterStatus(t *testing.T) {
+	_, client := newTestRedis(t)
+	ctx := context.Background()
+
+	const window = 300 * time.Millisecond
+	rl := NewSlidingWindowRateLimiter(client, window, 2)
+
+	before := time.Now()
+	status, err := rl.Status(ctx, "rl:user")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if status.Remaining != 2 || status.Total != 2 {
+		t.Fatalf("empty window = %+v", status)
+	}
+	if status.ResetsAt.Before(before) || status.ResetsAt.After(time.Now()) {
+		t.Fatalf("empty window ResetsAt = %s, want now", status.ResetsAt)
+	}
+
+	first := time.Now()
+	if _, _, err := rl.Allow(ctx, "rl:user"); err != nil {
+		t.Fatal(err)
+	}
+	for i := 0; i < 2; i++ {
+		status, err := rl.Status(ctx, "rl:user")
+		if err != nil {
+			t.Fatal(err)
+		}
+		if status.Remaining != 1 {
+			t.Fatalf("Status call %d: Remaining = %d, want 1", i+1, status.Remaining)
+		}
+	}
+
+	for i := 0; i < 2; i++ {
+		if _, _, err := rl.Allow(ctx, "rl:user"); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	status, err = rl.Status(ctx, "rl:user")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if status.Remaining != 0 {
+		t.Fatalf("Remaining = %d, want 0", status.Remaining)
+	}
+	// ResetsAt is millisecond precision, so allow for truncation.
+	earliest := first.Add(window).Add(-time.Millisecond)
+	if status.ResetsAt.Before(earliest) || status.ResetsAt.After(time.Now().Add(window)) {
+		t.Fatalf("ResetsAt = %s, want about %s", status.ResetsAt, first.Add(window))
+	}
+
+	time.Sleep(time.Until(status.ResetsAt) + 20*time.Millisecond)
+	status, err = rl.Status(ctx, "rl:user")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if status.Remaining != 2 {
+		t.Fatalf("after the window Remaining = %d, want 2", status.Remaining)
+	}
+}
@@ -1,18 +1,170 @@
 package slicetools
 
-import "sort"
+import (
+	"cmp"
+	"sort"
+)
 
+// Deprecated: use Remove.
 func DeleteElement[T comparable](slice []T, index int) []T {
-	return append(slice[:index], slice[index+1:]...)
+	return Remove(slice, index)
 }
 
+// Deprecated: use RemoveIndices.
 func DeleteElements[T comparable](slice []T, indices []int) []T {
+	return RemoveIndices(slice, indices)
+}
+
+// Remove deletes the element at index, reusing the backing array of slice.
+func Remove[T any](slice []T, index int) []T {
+	return append(slice[:index], slice[index+1:]...)
+}
+
+// RemoveIndices deletes the elements at the given indices, reusing the backing array of slice.
+func RemoveIndices[T any](slice []T, indices []int) []T {
 	// Sort indices in descending order
-	sort.Sort(sort.Reverse(sort.IntSlice(indices)))
+	sorted := append([]int(nil), indices...)
+	sort.Sort(sort.Reverse(sort.IntSlice(sorted)))
 
-	for _, index := range indices {
+	for _, index := range sorted {
 		slice = append(slice[:index], slice[index+1:]...)
 	}
 
 	return slice
 }
+
+// Filter returns the elements for which predicate returns true.
+func Filter[T any](slice []T, predicate func(T) bool) []T {
+	result := make([]T, 0, len(slice))
+	for _, v := range slice {
+		if predicate(v) {
+			result = append(result, v)
+		}
+	}
+	return result
+}
+
+// Map applies mapper to every element.
+func Map[T, U any](slice []T, mapper func(T) U) []U {
+	result := make([]U, len(slice))
+	for i, v := range slice {
+		result[i] = mapper(v)
+	}
+	return result
+}
+
+// Reduce folds the slice into a single value, starting from initial.
+func Reduce[T, U any](slice []T, initial U, accumulator func(U, T) U) U {
+	result := initial
+	for _, v := range slice {
+		result = accumulator(result, v)
+	}
+	return result
+}
+
+// Contains reports whether item is present in slice.
+func Contains[T comparable](slice []T, item T) bool {
+	for _, v := range slice {
+		if v == item {
+			return true
+		}
+	}
+	return false
+}
+
+// ContainsOrdered is Contains for ordered types. When isSorted is true the
+// slice must be sorted ascending and a binary search is used instead of a scan.
+func ContainsOrdered[T cmp.Ordered](slice []T, item T, isSorted bool) bool {
+	if !isSorted {
+		return Contains(slice, item)
+	}
+	i := sort.Search(len(slice), func(i int) bool { return slice[i] >= item })
+	return i < len(slice) && slice[i] == item
+}
+
+// Deduplicate removes repeated elements, keeping the first occurrence order.
+func Deduplicate[T comparable](slice []T) []T {
+	seen := make(map[T]struct{}, len(slice))
+	result := make([]T, 0, len(slice))
+	for _, v := range slice {
+		if _, ok := seen[v]; ok {
+			continue
+		}
+		seen[v] = struct{}{}
+		result = append(result, v)
+	}
+	return result
+}
+
+// Chunk splits slice into sub-slices of at most size elements. A size of zero
+// or less puts everything into a single chunk.
+func Chunk[T any](slice []T, size int) [][]T {
+	chunks := [][]T{}
+	if len(slice) == 0 {
+		return chunks
+	}
+	if size <= 0 {
+		size = len(slice)
+	}
+
+	for start := 0; start < len(slice); start += size {
+		end := start + size
+		if end > len(slice) {
+			end = len(slice)
+		}
+		chunks = append(chunks, slice[start:end:end])
+	}
+	return chunks
+}
+
+// GroupBy buckets the elements by the key returned for each one, preserving order within a bucket.
+func GroupBy[T any, K comparable](slice []T, key func(T) K) map[K][]T {
+	groups := make(map[K][]T)
+	for _, v := range slice {
+		k := key(v)
+		groups[k] = append(groups[k], v)
+	}
+	return groups
+}
+
+// Partition splits the slice into the elements that satisfy predicate and those that don't.
+func Partition[T any](slice []T, predicate func(T) bool) (matching, nonMatching []T) {
+	for _, v := range slice {
+		if predicate(v) {
+			matching = append(matching, v)
+		} else {
+			nonMatching = append(nonMatching, v)
+		}
+	}
+	return matching, nonMatching
+}
+
+type Pair[T, U any] struct {
+	First  T
+	Second U
+}
+
+// Zip pairs elements by index. The result is as long as the shorter input.
+func Zip[T, U any](a []T, b []U) []Pair[T, U] {
+	n := len(a)
+	if len(b) < n {
+		n = len(b)
+	}
+
+	pairs := make([]Pair[T, U], n)
+	for i := 0; i < n; i++ {
+		pairs[i] = Pair[T, U]{First: a[i], Second: b[i]}
+	}
+	return pairs
+}
+
+// Unzip is the inverse of Zip.
+func Unzip[T, U any](pairs []Pair[T, U]) ([]T, []U) {
+	a := make([]T, len(pairs))
+	b := make([]U, len(pairs))
+	for i, p := range pairs {
+		a[i] = p.First
+		b[i] = p.Second
+	}
+	return a, b
+}
@@ -0,0 +1,426 @@
+package slicetools
+
+import (
+	"fmt"
+	"reflect"
+	"sort"
+	"testing"
+)
+
+// interface{} based versions of Contains, Deduplicate and Chunk, as written
+// before generics, used as the baseline in the benchmarks below.
+
+func containsIface(slice []interface{}, item interface{}) bool {
+	for _, v := range slice {
+		if v == item {
+			return true
+		}
+	}
+	return false
+}
+
+func containsSortedIface(slice []interface{}, item interface{}) bool {
+	n := item.(int)
+	i := sort.Search(len(slice), func(i int) bool { return slice[i].(int) >= n })
+	return i < len(slice) && slice[i] == item
+}
+
+func deduplicateIface(slice []interface{}) []interface{} {
+	seen := make(map[interface{}]struct{}, len(slice))
+	result := make([]interface{}, 0, len(slice))
+	for _, v := range slice {
+		if _, ok := seen[v]; ok {
+			continue
+		}
+		seen[v] = struct{}{}
+		result = append(result, v)
+	}
+	return result
+}
+
+func chunkIface(slice []interface{}, size int) [][]interface{} {
+	chunks := [][]interface{}{}
+	for start := 0; start < len(slice); start += size {
+		end := start + size
+		if end > len(slice) {
+			end = len(slice)
+		}
+		chunks = append(chunks, slice[start:end:end])
+	}
+	return chunks
+}
+
+var benchSizes = []int{10, 1000, 100000}
+
+func sortedInts(n int) []int {
+	s := make([]int, n)
+	for i := range s {
+		s[i] = i
+	}
+	return s
+}
+
+func toIface(s []int) []interface{} {
+	out := make([]interface{}, len(s))
+	for i, v := range s {
+		out[i] = v
+	}
+	return out
+}
+
+func BenchmarkContains(b *testing.B) {
+	for _, n := range benchSizes {
+		ints := sortedInts(n)
+		ifaces := toIface(ints)
+		target := n - 1
+
+		b.Run(fmt.Sprintf("generic/%d", n), func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				Contains(ints, target)
+			}
+		})
+		b.Run(fmt.Sprintf("generic_sorted/%d", n), func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				ContainsOrdered(ints, target, true)
+			}
+		})
+		b.Run(fmt.Sprintf("interface/%d", n), func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				containsIface(ifaces, target)
+			}
+		})
+		b.Run(fmt.Sprintf("interface_sorted/%d", n), func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				containsSortedIface(ifaces, target)
+			}
+		})
+	}
+}
+
+func BenchmarkDeduplicate(b *testing.B) {
+	for _, n := range benchSizes {
+		ints := make([]int, n)
+		for i := range ints {
+			ints[i] = i % (n/2 + 1)
+		}
+		ifaces := toIface(ints)
+
+		b.Run(fmt.Sprintf("generic/%d", n), func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				Deduplicate(ints)
+			}
+		})
+		b.Run(fmt.Sprintf("interface/%d", n), func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				deduplicateIface(ifaces)
+			}
+		})
+	}
+}
+
+func BenchmarkChunk(b *testing.B) {
+	for _, n := range benchSizes {
+		ints := sortedInts(n)
+		ifaces := toIface(ints)
+
+		b.Run(fmt.Sprintf("generic/%d", n), func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				Chunk(ints, 7)
+			}
+		})
+		b.Run(fmt.Sprintf("interface/%d", n), func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				chunkIface(ifaces, 7)
+			}
+		})
+	}
+}
+
+func TestFilterMapReduce(t *testing.T) {
+	isEven := func(i int) bool { return i%2 == 0 }
+
+	if got := Filter([]int{1, 2, 3, 4}, isEven); !reflect.DeepEqual(got, []int{2, 4}) {
+		t.Errorf("Filter = %v", got)
+	}
+	if got := Filter([]int{1, 3}, isEven); got == nil || len(got) != 0 {
+		t.Errorf("Filter with no matches = %#v, want an empty slice", got)
+	}
+
+	if got := Map([]int{1, 2, 3}, func(i int) string { return fmt.Sprint(i * 10) }); !reflect.DeepEqual(got, []string{"10", "20", "30"}) {
+		t.Errorf("Map = %v", got)
+	}
+	if got := Map([]int(nil), func(i int) int { return i }); got == nil || len(got) != 0 {
+		t.Errorf("Map of nil = %#v, want an empty slice", got)
+	}
+
+	sum := Reduce([]int{1, 2, 3, 4}, 0, func(acc, v int) int { return acc + v })
+	if sum != 10 {
+		t.Errorf("Reduce sum = %d", sum)
+	}
+	joined := Reduce([]string{"a", "b", "c"}, ">", func(acc string, v string) string { return acc + v })
+	if joined != ">abc" {
+		t.Errorf("Reduce keeps order: got %q", joined)
+	}
+	if got := Reduce([]int(nil), 42, func(acc, v int) int { return acc + v }); got != 42 {
+		t.Errorf("Reduce of nil = %d, want the initial value", got)
+	}
+}
+
+func TestRemove(t *testing.T) {
+	tests := []struct {
+		name  string
+		in    []string
+		index int
+		want  []string
+	}{
+		{"first", []string{"a", "b", "c"}, 0, []string{"b", "c"}},
+		{"middle", []string{"a", "b", "c"}, 1, []string{"a", "c"}},
+		{"last", []string{"a", "b", "c"}, 2, []string{"a", "b"}},
+		{"only", []string{"a"}, 0, []string{}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Remove(tt.in, tt.index); !reflect.DeepEqual(got, tt.want) {
+				t.Fatalf("Remove = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestRemoveIndices(t *testing.T) {
+	tests := []struct {
+		name    string
+		in      []int
+		indices []int
+		want    []int
+	}{
+		{"ascending", []int{0, 1, 2, 3, 4}, []int{1, 3}, []int{0, 2, 4}},
+		{"unsorted", []int{0, 1, 2, 3, 4}, []int{4, 0, 2}, []int{1, 3}},
+		{"all", []int{0, 1, 2}, []int{2, 0, 1}, []int{}},
+		{"none", []int{0, 1, 2}, nil, []int{0, 1, 2}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			indices := append([]int(nil), tt.indices...)
+			got := RemoveIndices(tt.in, indices)
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Fatalf("RemoveIndices = %v, want %v", got, tt.want)
+			}
+			if !reflect.DeepEqual(indices, tt.indices) {
+				t.Fatalf("caller's indices changed to %v, want %v", indices, tt.indices)
+			}
+		})
+	}
+}
+
+func TestChunk(t *testing.T) {
+	tests := []struct {
+		name string
+		in   []int
+		size int
+		want [][]int
+	}{
+		{"even split", []int{1, 2, 3, 4}, 2, [][]int{{1, 2}, {3, 4}}},
+		{"short last chunk", []int{1, 2, 3, 4, 5}, 2, [][]int{{1, 2}, {3, 4}, {5}}},
+		{"size larger than slice", []int{1, 2}, 5, [][]int{{1, 2}}},
+		{"size one", []int{1, 2}, 1, [][]int{{1}, {2}}},
+		{"zero size", []int{1, 2, 3}, 0, [][]int{{1, 2, 3}}},
+		{"negative size", []int{1, 2, 3}, -1, [][]int{{1, 2, 3}}},
+		{"empty", []int{}, 2, [][]int{}},
+		{"nil", nil, 2, [][]int{}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := Chunk(tt.in, tt.size)
+			if got == nil {
+				t.Fatal("Chunk returned a nil outer slice")
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Fatalf("Chunk = %v, want %v", got, tt.want)
+			}
+		})
+	}
+
+	// Chunks are capped, so appending to one must not overwrite the next.
+	in := []int{1, 2, 3, 4}
+	chunks := Chunk(in, 2)
+	_ = append(chunks[0], 99)
+	if in[2] != 3 || chunks[1][0] != 3 {
+		t.Fatalf("appending to a chunk overwrote the next one: %v", in)
+	}
+}
+
+func TestContains(t *testing.T) {
+	if !Contains([]string{"a", "b"}, "b") {
+		t.Error("Contains missed a present item")
+	}
+	if Contains([]string{"a", "b"}, "c") {
+		t.Error("Contains found a missing item")
+	}
+	if Contains([]int(nil), 0) {
+		t.Error("Contains found an item in a nil slice")
+	}
+}
+
+func TestContainsOrdered(t *testing.T) {
+	sorted := []int{1, 3, 5, 7, 9}
+	unsorted := []int{9, 1, 7, 3, 5}
+
+	for item := 0; item <= 10; item++ {
+		want := item%2 == 1 && item < 10
+		if got := ContainsOrdered(sorted, item, true); got != want {
+			t.Errorf("sorted, %d: got %v, want %v", item, got, want)
+		}
+		if got := ContainsOrdered(unsorted, item, false); got != want {
+			t.Errorf("unsorted, %d: got %v, want %v", item, got, want)
+		}
+	}
+
+	if ContainsOrdered([]string(nil), "a", true) {
+		t.Error("found an item in a nil slice")
+	}
+	if !ContainsOrdered([]string{"apple", "banana", "cherry"}, "banana", true) {
+		t.Error("missed a string in a sorted slice")
+	}
+}
+
+func TestDeduplicate(t *testing.T) {
+	tests := []struct {
+		name string
+		in   []string
+		want []string
+	}{
+		{"keeps first occurrence order", []string{"b", "a", "b", "c", "a"}, []string{"b", "a", "c"}},
+		{"no duplicates", []string{"c", "b", "a"}, []string{"c", "b", "a"}},
+		{"all the same", []string{"x", "x", "x"}, []string{"x"}},
+		{"empty", nil, []string{}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Deduplicate(tt.in); !reflect.DeepEqual(got, tt.want) {
+				t.Fatalf("Deduplicate = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestGroupBy(t *testing.T) {
+	words := []string{"apple", "avocado", "banana", "blueberry", "cherry"}
+	got := GroupBy(words, func(s string) byte { return s[0] })
+	want := map[byte][]string{
+		'a': {"apple", "avocado"},
+		'b': {"banana", "blueberry"},
+		'c': {"cherry"},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Fatalf("got %v, want %v", got, want)
+	}
+
+	if got := GroupBy([]int(nil), func(i int) int { return i }); len(got) != 0 {
+		t.Fatalf("expected an empty map for nil input, got %v", got)
+	}
+}
+
+func TestPartition(t *testing.T) {
+	isEven := func(i int) bool { return i%2 == 0 }
+	tests := []struct {
+		name         string
+		in           []int
+		wantMatch    []int
+		wantNonMatch []int
+	}{
+		{"mixed", []int{1, 2, 3, 4, 5}, []int{2, 4}, []int{1, 3, 5}},
+		{"all match", []int{2, 4}, []int{2, 4}, nil},
+		{"none match", []int{1, 3}, nil, []int{1, 3}},
+		{"empty", nil, nil, nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			match, nonMatch := Partition(tt.in, isEven)
+			if !reflect.DeepEqual(match, tt.wantMatch) {
+				t.Errorf("matching = %v, want %v", match, tt.wantMatch)
+			}
+			if !reflect.DeepEqual(nonMatch, tt.wantNonMatch) {
+				t.Errorf("nonMatching = %v, want %v", nonMatch, tt.wantNonMatch)
+			}
+		})
+	}
+}
+
+func TestZipUnzip(t *testing.T) {
+	tests := []struct {
+		name string
+		a    []int
+		b    []string
+		want []Pair[int, string]
+	}{
+		{"same length", []int{1, 2}, []string{"a", "b"}, []Pair[int, string]{{1, "a"}, {2, "b"}}},
+		{"a shorter", []int{1}, []string{"a", "b"}, []Pair[int, string]{{1, "a"}}},
+		{"b shorter", []int{1, 2, 3}, []string{"a"}, []Pair[int, string]{{1, "a"}}},
+		{"empty", nil, nil, []Pair[int, string]{}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := Zip(tt.a, tt.b)
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Fatalf("Zip = %v, want %v", got, tt.want)
+			}
+
+			a, b := Unzip(got)
+			n := len(tt.want)
+			if !reflect.DeepEqual(a, append([]int{}, tt.a[:n]...)) || !reflect.DeepEqual(b, append([]string{}, tt.b[:n]...)) {
+				t.Fatalf("Unzip = %v, %v", a, b)
+			}
+		})
+	}
+}
+
+func BenchmarkGroupBy(b *testing.B) {
+	for _, n := range benchSizes {
+		ints := sortedInts(n)
+		b.Run(fmt.Sprint(n), func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				GroupBy(ints, func(v int) int { return v % 16 })
+			}
+		})
+	}
+}
+
+func BenchmarkPartition(b *testing.B) {
+	for _, n := range benchSizes {
+		ints := sortedInts(n)
+		b.Run(fmt.Sprint(n), func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				Partition(ints, func(v int) bool { return v%2 == 0 })
+			}
+		})
+	}
+}
+
+func BenchmarkZip(b *testing.B) {
+	for _, n := range benchSizes {
+		ints := sortedInts(n)
+		b.Run(fmt.Sprint(n), func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				Zip(ints, ints)
+			}
+		})
+	}
+}
+
+func BenchmarkUnzip(b *testing.B) {
+	for _, n := range benchSizes {
+		pairs := Zip(sortedInts(n), sortedInts(n))
+		b.Run(fmt.Sprint(n), func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				Unzip(pairs)
+			}
+		})
+	}
+}
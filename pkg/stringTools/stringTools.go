@@ -5,6 +5,10 @@ import (
 	"reflect"
 	"strconv"
 	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"golang.org/x/text/unicode/norm"
 )
 
 func RightValue(input string, length int) string {
@@ -24,6 +28,54 @@ func RightValueWithFormat(format string, input string, length int) string {
 	return strings.Repeat(format, length-len(input)) + input
 }
 
+// Returns the first length characters (runes) of input, or the whole input if it is shorter.
+func LeftValue(input string, length int) string {
+	if length <= 0 {
+		return ""
+	}
+	runes := []rune(input)
+	if len(runes) <= length {
+		return input
+	}
+
+	return string(runes[:length])
+}
+
+// Left side equivalent of RightValueWithFormat: cuts input to length characters,
+// or right-pads it with format until it reaches length.
+func LeftValueWithFormat(format string, input string, length int) string {
+	inputLen := utf8.RuneCountInString(input)
+	if inputLen >= length {
+		return LeftValue(input, length)
+	}
+
+	return input + buildPadding(format, length-inputLen)
+}
+
+// Pads input on the left with pad (repeated and cut as needed) to exactly totalLen runes.
+// Inputs already totalLen runes or longer are returned unchanged.
+func PadLeft(input string, pad string, totalLen int) string {
+	padding := buildPadding(pad, totalLen-utf8.RuneCountInString(input))
+	return padding + input
+}
+
+// Pads input on the right with pad (repeated and cut as needed) to exactly totalLen runes.
+// Inputs already totalLen runes or longer are returned unchanged.
+func PadRight(input string, pad string, totalLen int) string {
+	padding := buildPadding(pad, totalLen-utf8.RuneCountInString(input))
+	return input + padding
+}
+
+func buildPadding(pad string, count int) string {
+	padLen := utf8.RuneCountInString(pad)
+	if count <= 0 || padLen == 0 {
+		return ""
+	}
+	repeated := []rune(strings.Repeat(pad, (count+padLen-1)/padLen))
+
+	return string(repeated[:count])
+}
+
 func ParseStringToBoolPtr(s string) *bool {
 	if strings.TrimSpace(s) == "" {
 		return nil
@@ -146,3 +198,425 @@ func StructToString(s interface{}, delimiter string) string {
 
 	return sb.String()
 }
+
+// Converts to camelCase, e.g. "user_id" -> "userId", "HTTPServer" -> "httpServer".
+func ToCamelCase(s string) string {
+	words := splitWords(s)
+	for i, w := range words {
+		if i == 0 {
+			words[i] = strings.ToLower(w)
+		} else {
+			words[i] = capitalize(w)
+		}
+	}
+	return strings.Join(words, "")
+}
+
+// Converts to PascalCase, e.g. "user_id" -> "UserId".
+func ToPascalCase(s string) string {
+	words := splitWords(s)
+	for i, w := range words {
+		words[i] = capitalize(w)
+	}
+	return strings.Join(words, "")
+}
+
+// Converts to snake_case, e.g. "HTTPServer" -> "http_server", "field1" -> "field1".
+func ToSnakeCase(s string) string {
+	return joinLower(splitWords(s), "_")
+}
+
+// Converts to kebab-case, e.g. "UserID" -> "user-id".
+func ToKebabCase(s string) string {
+	return joinLower(splitWords(s), "-")
+}
+
+func joinLower(words []string, sep string) string {
+	for i, w := range words {
+		words[i] = strings.ToLower(w)
+	}
+	return strings.Join(words, sep)
+}
+
+func capitalize(w string) string {
+	r, size := utf8.DecodeRuneInString(w)
+	if r == utf8.RuneError {
+		return w
+	}
+	return string(unicode.ToUpper(r)) + strings.ToLower(w[size:])
+}
+
+// Splits on any non letter/digit separator and on case boundaries. Runs of
+// capitals are kept together as an acronym ("HTTPServer" -> "HTTP", "Server")
+// and digits stay attached to the word before them.
+func splitWords(s string) []string {
+	var words []string
+	var current []rune
+
+	flush := func() {
+		if len(current) > 0 {
+			words = append(words, string(current))
+			current = current[:0]
+		}
+	}
+
+	runes := []rune(s)
+	for i, r := range runes {
+		if !unicode.IsLetter(r) && !unicode.IsDigit(r) && !unicode.IsMark(r) {
+			flush()
+			continue
+		}
+		if unicode.IsUpper(r) && len(current) > 0 {
+			prev := current[len(current)-1]
+			nextIsLower := i+1 < len(runes) && unicode.IsLower(runes[i+1])
+			if !unicode.IsUpper(prev) || nextIsLower {
+				flush()
+			}
+		}
+		current = append(current, r)
+	}
+	flush()
+
+	return words
+}
+
+// Cuts s to at most maxLen runes, ellipsis included. The ellipsis is only
+// appended when s is actually shortened. The cut is moved back to a character
+// boundary so a combining mark (e.g. Thai vowels and tone marks) or an emoji
+// modifier is never separated from its base.
+func Truncate(s string, maxLen int, ellipsis string) string {
+	if maxLen <= 0 {
+		return ""
+	}
+	runes := []rune(s)
+	if len(runes) <= maxLen {
+		return s
+	}
+
+	keep := maxLen - utf8.RuneCountInString(ellipsis)
+	if keep < 0 {
+		return string(runes[:clusterCut(runes, maxLen)])
+	}
+	return string(runes[:clusterCut(runes, keep)]) + ellipsis
+}
+
+// Like Truncate but the budget is in bytes, e.g. for Elasticsearch field size
+// limits. The cut never splits a multi-byte rune or a character from its marks.
+func TruncateBytes(s string, maxBytes int, ellipsis string) string {
+	if maxBytes <= 0 {
+		return ""
+	}
+	if len(s) <= maxBytes {
+		return s
+	}
+
+	keep := maxBytes - len(ellipsis)
+	if keep < 0 {
+		keep = maxBytes
+		ellipsis = ""
+	}
+	for keep > 0 && !utf8.RuneStart(s[keep]) {
+		keep--
+	}
+	runes := []rune(s[:keep])
+	if cut := clusterCut([]rune(s), len(runes)); cut < len(runes) {
+		keep = len(string(runes[:cut]))
+	}
+	return s[:keep] + ellipsis
+}
+
+// Reports whether a new user-perceived character starts at runes[i]. This is
+// a subset of the UAX #29 rules: combining marks, ZWJ sequences, variation
+// selectors, emoji skin tone modifiers and regional indicator pairs stay
+// attached to what precedes them.
+func isClusterBoundary(runes []rune, i int) bool {
+	if i <= 0 || i >= len(runes) {
+		return true
+	}
+	r, prev := runes[i], runes[i-1]
+	if prev == '\u200d' || isClusterExtend(r) {
+		return false
+	}
+	if isRegionalIndicator(r) && isRegionalIndicator(prev) {
+		n := 0
+		for j := i - 1; j >= 0 && isRegionalIndicator(runes[j]); j-- {
+			n++
+		}
+		return n%2 == 0
+	}
+	return true
+}
+
+func isClusterExtend(r rune) bool {
+	return unicode.In(r, unicode.Mn, unicode.Me, unicode.Mc) ||
+		r == '\u200d' || r == '\u0e33' || r == '\u0eb3' ||
+		(r >= 0x1f3fb && r <= 0x1f3ff)
+}
+
+func isRegionalIndicator(r rune) bool {
+	return r >= 0x1f1e6 && r <= 0x1f1ff
+}
+
+// Largest character boundary at or before n.
+func clusterCut(runes []rune, n int) int {
+	if n >= len(runes) {
+		return len(runes)
+	}
+	for n > 0 && !isClusterBoundary(runes, n) {
+		n--
+	}
+	return n
+}
+
+func splitClusters(s string) [][]rune {
+	runes := []rune(s)
+	var clusters [][]rune
+	start := 0
+	for i := 1; i <= len(runes); i++ {
+		if isClusterBoundary(runes, i) {
+			clusters = append(clusters, runes[start:i])
+			start = i
+		}
+	}
+	return clusters
+}
+
+type wrapPiece struct {
+	text        string
+	width       int
+	spaceBefore bool
+}
+
+// Inserts newlines at word boundaries so no line is longer than maxWidth runes.
+// CJK ideographs may break between any two characters, and words longer than
+// maxWidth are split between characters, never between a character and its
+// combining marks. A single character wider than maxWidth (such as a long ZWJ
+// emoji sequence) is kept whole on its own line. Existing newlines are kept.
+func WrapText(s string, maxWidth int) string {
+	if maxWidth <= 0 {
+		return s
+	}
+
+	paragraphs := strings.Split(s, "\n")
+	for i, p := range paragraphs {
+		paragraphs[i] = wrapLine(p, maxWidth)
+	}
+	return strings.Join(paragraphs, "\n")
+}
+
+func wrapLine(s string, maxWidth int) string {
+	var sb strings.Builder
+	lineLen := 0
+
+	for _, piece := range splitWrapPieces(s) {
+		add := piece.width
+		if piece.spaceBefore && lineLen > 0 {
+			add++
+		}
+		if lineLen > 0 && lineLen+add > maxWidth {
+			sb.WriteString("\n")
+			lineLen = 0
+		} else if piece.spaceBefore && lineLen > 0 {
+			sb.WriteString(" ")
+			lineLen++
+		}
+
+		runes := []rune(piece.text)
+		for len(runes) > maxWidth-lineLen {
+			cut := clusterCut(runes, maxWidth-lineLen)
+			if cut == 0 {
+				if lineLen > 0 {
+					sb.WriteString("\n")
+					lineLen = 0
+					continue
+				}
+				cut = len(splitClusters(string(runes))[0])
+			}
+			sb.WriteString(string(runes[:cut]))
+			runes = runes[cut:]
+			if len(runes) == 0 {
+				lineLen = maxWidth
+				break
+			}
+			sb.WriteString("\n")
+			lineLen = 0
+		}
+		sb.WriteString(string(runes))
+		lineLen += len(runes)
+	}
+
+	return sb.String()
+}
+
+func splitWrapPieces(s string) []wrapPiece {
+	var pieces []wrapPiece
+	for _, word := range strings.Fields(s) {
+		first := true
+		var run []rune
+		flush := func() {
+			if len(run) > 0 {
+				pieces = append(pieces, wrapPiece{text: string(run), width: len(run), spaceBefore: first})
+				first = false
+				run = nil
+			}
+		}
+		for _, cluster := range splitClusters(word) {
+			if isCJK(cluster[0]) {
+				flush()
+				pieces = append(pieces, wrapPiece{text: string(cluster), width: len(cluster), spaceBefore: first})
+				first = false
+				continue
+			}
+			run = append(run, cluster...)
+		}
+		flush()
+	}
+	return pieces
+}
+
+func isCJK(r rune) bool {
+	return unicode.In(r, unicode.Han, unicode.Hiragana, unicode.Katakana, unicode.Hangul)
+}
+
+// Letters that carry a diacritic but have no Unicode decomposition.
+var diacriticReplacer = strings.NewReplacer(
+	"ß", "ss", "ø", "o", "Ø", "O", "æ", "ae", "Æ", "AE",
+	"đ", "d", "Đ", "D", "ł", "l", "Ł", "L", "œ", "oe", "Œ", "OE",
+)
+
+// Removes accents from letters, e.g. "é" -> "e". Only marks produced by
+// decomposing a precomposed letter are dropped, so scripts such as Thai that
+// use standalone combining marks keep them.
+func RemoveDiacritics(s string) string {
+	var sb strings.Builder
+	for _, r := range norm.NFC.String(s) {
+		decomposed := norm.NFD.String(string(r))
+		if utf8.RuneCountInString(decomposed) == 1 {
+			sb.WriteRune(r)
+			continue
+		}
+		for _, d := range decomposed {
+			if !unicode.Is(unicode.Mn, d) {
+				sb.WriteRune(d)
+			}
+		}
+	}
+	return diacriticReplacer.Replace(sb.String())
+}
+
+type SlugOptions struct {
+	// Maximum slug length in runes, zero or less means no limit.
+	MaxLen int
+	// Drops every non-ASCII character, e.g. Thai or Arabic text.
+	ASCIIOnly bool
+}
+
+// Builds a URL-safe slug: lowercased, diacritics removed and every run of
+// spaces or punctuation replaced by a single "-".
+func GenerateSlug(input string, maxLen int) string {
+	return GenerateSlugWithOptions(input, SlugOptions{MaxLen: maxLen})
+}
+
+func GenerateSlugWithOptions(input string, opts SlugOptions) string {
+	var sb strings.Builder
+	pendingDash := false
+
+	for _, r := range strings.ToLower(RemoveDiacritics(input)) {
+		if opts.ASCIIOnly && r > unicode.MaxASCII {
+			continue
+		}
+		if !unicode.IsLetter(r) && !unicode.IsDigit(r) && !unicode.IsMark(r) {
+			pendingDash = sb.Len() > 0
+			continue
+		}
+		if pendingDash {
+			sb.WriteRune('-')
+			pendingDash = false
+		}
+		sb.WriteRune(r)
+	}
+
+	slug := sb.String()
+	if opts.MaxLen > 0 {
+		slug = LeftValue(slug, opts.MaxLen)
+	}
+	return strings.TrimRight(slug, "-")
+}
+
+// Reports whether s is non-empty and made of ASCII digits only, i.e. what
+// ParseStringToIntPtr can parse without a sign.
+func IsNumeric(s string) bool {
+	if s == "" {
+		return false
+	}
+	for _, r := range s {
+		if r < '0' || r > '9' {
+			return false
+		}
+	}
+	return true
+}
+
+// Reports whether s is non-empty and made of Unicode letters only. Combining
+// marks are accepted so that scripts such as Thai pass.
+func IsAlpha(s string) bool {
+	if s == "" {
+		return false
+	}
+	for _, r := range s {
+		if !unicode.IsLetter(r) && !unicode.IsMark(r) {
+			return false
+		}
+	}
+	return true
+}
+
+// Reports whether s is non-empty and made of Unicode letters and digits only.
+func IsAlphanumeric(s string) bool {
+	if s == "" {
+		return false
+	}
+	for _, r := range s {
+		if !unicode.IsLetter(r) && !unicode.IsMark(r) && !unicode.IsDigit(r) {
+			return false
+		}
+	}
+	return true
+}
+
+// Reports whether s is empty or only whitespace. Unlike strings.TrimSpace this
+// also treats the Unicode separator categories (Zs, Zl, Zp) and invisible
+// zero-width space / byte order mark characters as blank.
+func IsBlank(s string) bool {
+	for _, r := range s {
+		if unicode.IsSpace(r) || unicode.In(r, unicode.Zs, unicode.Zl, unicode.Zp) {
+			continue
+		}
+		if r == '\u200b' || r == '\ufeff' {
+			continue
+		}
+		return false
+	}
+	return true
+}
+
+// Reports whether every rune of s appears in charset.
+func ContainsOnly(s, charset string) bool {
+	for _, r := range s {
+		if !strings.ContainsRune(charset, r) {
+			return false
+		}
+	}
+	return true
+}
+
+// Removes every character that is not an ASCII digit.
+func ExtractDigits(s string) string {
+	var sb strings.Builder
+	for _, r := range s {
+		if r >= '0' && r <= '9' {
+			sb.WriteRune(r)
+		}
+	}
+	return sb.String()
+}
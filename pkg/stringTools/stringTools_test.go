@@ -0,0 +1,361 @@
+package stringtools
+
+import "testing"
+
+func TestTruncate(t *testing.T) {
+	tests := []struct {
+		name     string
+		s        string
+		maxLen   int
+		ellipsis string
+		want     string
+	}{
+		{"ascii fits", "hello", 5, "...", "hello"},
+		{"ascii cut", "hello world", 8, "...", "hello..."},
+		{"ascii no ellipsis", "hello world", 5, "", "hello"},
+		{"ellipsis longer than budget", "hello world", 2, "...", "he"},
+		{"zero length", "hello", 0, "...", ""},
+		{"emoji counts runes", "😀😃😄😁", 3, "…", "😀😃…"},
+		{"emoji keeps skin tone", "👍🏽ok", 3, "", "👍🏽o"},
+		{"emoji never splits skin tone", "👍🏽ok", 1, "", ""},
+		{"emoji keeps zwj sequence whole", "👨‍👩‍👧 hi", 4, "…", "…"},
+		{"flag pair kept together", "🇹🇭🇯🇵", 3, "", "🇹🇭"},
+		{"thai fits", "สวัสดี", 6, "…", "สวัสดี"},
+		{"thai cut on base", "สวัสดีครับ", 3, "", "สวั"},
+		{"thai never leaves mark alone", "สวัสดีครับ", 2, "", "ส"},
+		{"thai with ellipsis", "สวัสดีครับ", 6, "…", "สวัส…"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Truncate(tt.s, tt.maxLen, tt.ellipsis); got != tt.want {
+				t.Fatalf("Truncate(%q, %d, %q) = %q, want %q", tt.s, tt.maxLen, tt.ellipsis, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestTruncateBytes(t *testing.T) {
+	tests := []struct {
+		name     string
+		s        string
+		maxBytes int
+		ellipsis string
+		want     string
+	}{
+		{"ascii fits", "hello", 5, "...", "hello"},
+		{"ascii cut", "hello world", 8, "...", "hello..."},
+		{"emoji not split", "a😀b", 3, "", "a"},
+		{"emoji skin tone kept", "👍🏽ok", 6, "", ""},
+		{"thai not split mid rune", "สวัสดี", 5, "", "ส"},
+		{"thai never leaves mark alone", "สวัสดี", 6, "", "ส"},
+		{"thai mark kept with base", "สวัสดี", 9, "", "สวั"},
+		{"thai cut on base", "สวัสดี", 12, "", "สวัส"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := TruncateBytes(tt.s, tt.maxBytes, tt.ellipsis); got != tt.want {
+				t.Fatalf("TruncateBytes(%q, %d, %q) = %q, want %q", tt.s, tt.maxBytes, tt.ellipsis, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestWrapText(t *testing.T) {
+	tests := []struct {
+		name     string
+		s        string
+		maxWidth int
+		want     string
+	}{
+		{"ascii words", "the quick brown fox", 10, "the quick\nbrown fox"},
+		{"ascii long word split", "abcdefgh", 3, "abc\ndef\ngh"},
+		{"ascii keeps newlines", "ab cd\nef", 2, "ab\ncd\nef"},
+		{"emoji", "😀😃 😄😁", 2, "😀😃\n😄😁"},
+		{"emoji skin tone not split", "👍🏽👍🏽👍🏽", 3, "👍🏽\n👍🏽\n👍🏽"},
+		{"emoji wider than line kept whole", "👨‍👩‍👧", 2, "👨‍👩‍👧"},
+		{"cjk breaks anywhere", "日本語のテキスト", 3, "日本語\nのテキ\nスト"},
+		{"thai words", "สวัสดี ครับ", 6, "สวัสดี\nครับ"},
+		{"thai long word split on base", "สวัสดีครับ", 3, "สวั\nสดี\nครั\nบ"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := WrapText(tt.s, tt.maxWidth); got != tt.want {
+				t.Fatalf("WrapText(%q, %d) = %q, want %q", tt.s, tt.maxWidth, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestLeftValue(t *testing.T) {
+	tests := []struct {
+		input  string
+		length int
+		want   string
+	}{
+		{"abcdef", 3, "abc"},
+		{"abc", 3, "abc"},
+		{"ab", 5, "ab"},
+		{"", 3, ""},
+		{"abc", 0, ""},
+		{"abc", -1, ""},
+		{"สวัสดี", 2, "สว"},
+		{"日本語テキスト", 3, "日本語"},
+	}
+
+	for _, tt := range tests {
+		if got := LeftValue(tt.input, tt.length); got != tt.want {
+			t.Errorf("LeftValue(%q, %d) = %q, want %q", tt.input, tt.length, got, tt.want)
+		}
+	}
+}
+
+func TestLeftValueWithFormat(t *testing.T) {
+	tests := []struct {
+		format string
+		input  string
+		length int
+		want   string
+	}{
+		{"0", "12", 5, "12000"},
+		{"ab", "x", 5, "xabab"},
+		{"ab", "x", 4, "xaba"},
+		{"xyz", "abcdef", 3, "abc"},
+		{"0", "", 3, "000"},
+		{"", "ab", 5, "ab"},
+		{"-", "abc", 0, ""},
+		{"ๆ", "日本", 4, "日本ๆๆ"},
+		{"日本", "a", 4, "a日本日"},
+	}
+
+	for _, tt := range tests {
+		if got := LeftValueWithFormat(tt.format, tt.input, tt.length); got != tt.want {
+			t.Errorf("LeftValueWithFormat(%q, %q, %d) = %q, want %q", tt.format, tt.input, tt.length, got, tt.want)
+		}
+	}
+}
+
+func TestPad(t *testing.T) {
+	tests := []struct {
+		input     string
+		pad       string
+		totalLen  int
+		wantLeft  string
+		wantRight string
+	}{
+		{"7", "0", 3, "007", "700"},
+		{"x", "ab", 4, "abax", "xaba"},
+		{"abcd", "0", 3, "abcd", "abcd"},
+		{"abc", "0", 3, "abc", "abc"},
+		{"", "0", 2, "00", "00"},
+		{"", "", 2, "", ""},
+		{"ab", "", 5, "ab", "ab"},
+		{"ab", "0", -1, "ab", "ab"},
+		{"สวัสดี", ".", 8, "..สวัสดี", "สวัสดี.."},
+		{"1", "日本", 4, "日本日1", "1日本日"},
+	}
+
+	for _, tt := range tests {
+		if got := PadLeft(tt.input, tt.pad, tt.totalLen); got != tt.wantLeft {
+			t.Errorf("PadLeft(%q, %q, %d) = %q, want %q", tt.input, tt.pad, tt.totalLen, got, tt.wantLeft)
+		}
+		if got := PadRight(tt.input, tt.pad, tt.totalLen); got != tt.wantRight {
+			t.Errorf("PadRight(%q, %q, %d) = %q, want %q", tt.input, tt.pad, tt.totalLen, got, tt.wantRight)
+		}
+	}
+}
+
+func TestCaseConversion(t *testing.T) {
+	tests := []struct {
+		in     string
+		camel  string
+		pascal string
+		snake  string
+		kebab  string
+	}{
+		{"HTTPServer", "httpServer", "HttpServer", "http_server", "http-server"},
+		{"user_id", "userId", "UserId", "user_id", "user-id"},
+		{"UserID", "userId", "UserId", "user_id", "user-id"},
+		{"userIDToken", "userIdToken", "UserIdToken", "user_id_token", "user-id-token"},
+		{"field1", "field1", "Field1", "field1", "field1"},
+		{"field1Name", "field1Name", "Field1Name", "field1_name", "field1-name"},
+		{"user-name here", "userNameHere", "UserNameHere", "user_name_here", "user-name-here"},
+		{"__private__", "private", "Private", "private", "private"},
+		{"  --trim me--  ", "trimMe", "TrimMe", "trim_me", "trim-me"},
+		{"a__b", "aB", "AB", "a_b", "a-b"},
+		{"", "", "", "", ""},
+		{"___", "", "", "", ""},
+		{"ชื่อ ผู้ใช้", "ชื่อผู้ใช้", "ชื่อผู้ใช้", "ชื่อ_ผู้ใช้", "ชื่อ-ผู้ใช้"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.in, func(t *testing.T) {
+			if got := ToCamelCase(tt.in); got != tt.camel {
+				t.Errorf("ToCamelCase = %q, want %q", got, tt.camel)
+			}
+			if got := ToPascalCase(tt.in); got != tt.pascal {
+				t.Errorf("ToPascalCase = %q, want %q", got, tt.pascal)
+			}
+			if got := ToSnakeCase(tt.in); got != tt.snake {
+				t.Errorf("ToSnakeCase = %q, want %q", got, tt.snake)
+			}
+			if got := ToKebabCase(tt.in); got != tt.kebab {
+				t.Errorf("ToKebabCase = %q, want %q", got, tt.kebab)
+			}
+		})
+	}
+}
+
+func TestRemoveDiacritics(t *testing.T) {
+	tests := []struct {
+		in, want string
+	}{
+		{"café", "cafe"},
+		{"cafe\u0301", "cafe"},
+		{"Straße", "Strasse"},
+		{"Søren Ærø", "Soren AEro"},
+		{"Łódź", "Lodz"},
+		{"Ñandú", "Nandu"},
+		{"plain ascii", "plain ascii"},
+		{"สวัสดีครับ", "สวัสดีครับ"},
+		{"ที่นี่", "ที่นี่"},
+		{"", ""},
+	}
+
+	for _, tt := range tests {
+		if got := RemoveDiacritics(tt.in); got != tt.want {
+			t.Errorf("RemoveDiacritics(%q) = %q, want %q", tt.in, got, tt.want)
+		}
+	}
+}
+
+func TestGenerateSlug(t *testing.T) {
+	tests := []struct {
+		in     string
+		maxLen int
+		want   string
+	}{
+		{"Hello World", 0, "hello-world"},
+		{"Crème Brûlée", 0, "creme-brulee"},
+		{"Große Straße", 0, "grosse-strasse"},
+		{"Smørrebrød", 0, "smorrebrod"},
+		{"Hello,  World!!! -- again", 0, "hello-world-again"},
+		{"...leading and trailing...", 0, "leading-and-trailing"},
+		{"hello world foo", 6, "hello"},
+		{"hello world foo", 12, "hello-world"},
+		{"a - b", 2, "a"},
+		{"สวัสดี ชาวโลก", 0, "สวัสดี-ชาวโลก"},
+		{"!!!", 0, ""},
+		{"", 10, ""},
+	}
+
+	for _, tt := range tests {
+		if got := GenerateSlug(tt.in, tt.maxLen); got != tt.want {
+			t.Errorf("GenerateSlug(%q, %d) = %q, want %q", tt.in, tt.maxLen, got, tt.want)
+		}
+	}
+}
+
+func TestGenerateSlugWithOptions(t *testing.T) {
+	tests := []struct {
+		in   string
+		opts SlugOptions
+		want string
+	}{
+		{"บทความ Go 101", SlugOptions{}, "บทความ-go-101"},
+		{"บทความ Go 101", SlugOptions{ASCIIOnly: true}, "go-101"},
+		{"Go บทความ 101", SlugOptions{ASCIIOnly: true}, "go-101"},
+		{"สวัสดี", SlugOptions{ASCIIOnly: true}, ""},
+		{"Café สวัสดี", SlugOptions{ASCIIOnly: true}, "cafe"},
+		{"Go บทความ 101", SlugOptions{ASCIIOnly: true, MaxLen: 3}, "go"},
+		{"สวัสดี ชาวโลก", SlugOptions{MaxLen: 7}, "สวัสดี"},
+	}
+
+	for _, tt := range tests {
+		if got := GenerateSlugWithOptions(tt.in, tt.opts); got != tt.want {
+			t.Errorf("GenerateSlugWithOptions(%q, %+v) = %q, want %q", tt.in, tt.opts, got, tt.want)
+		}
+	}
+}
+
+func TestCharacterClassPredicates(t *testing.T) {
+	tests := []struct {
+		in                             string
+		numeric, alpha, alnum, isBlank bool
+	}{
+		{"", false, false, false, true},
+		{"0123", true, false, true, false},
+		{"-1", false, false, false, false},
+		{"1.5", false, false, false, false},
+		{"١٢٣", false, false, true, false}, // Arabic-Indic digits
+		{"๑๒๓", false, false, true, false}, // Thai digits
+		{"１２３", false, false, true, false}, // fullwidth digits
+		{"abc", false, true, true, false},
+		{"สวัสดี", false, true, true, false},
+		{"Café", false, true, true, false},
+		{"abc123", false, false, true, false},
+		{"a b", false, false, false, false},
+		{" \t\n", false, false, false, true},
+		{"\u3000", false, false, false, true}, // ideographic space
+		{"\u200b", false, false, false, true}, // zero-width space
+		{"\ufeff", false, false, false, true}, // byte order mark
+		{"   ", false, false, false, true},
+		{"\u200b x", false, false, false, false},
+	}
+
+	for _, tt := range tests {
+		if got := IsNumeric(tt.in); got != tt.numeric {
+			t.Errorf("IsNumeric(%q) = %v", tt.in, got)
+		}
+		if got := IsAlpha(tt.in); got != tt.alpha {
+			t.Errorf("IsAlpha(%q) = %v", tt.in, got)
+		}
+		if got := IsAlphanumeric(tt.in); got != tt.alnum {
+			t.Errorf("IsAlphanumeric(%q) = %v", tt.in, got)
+		}
+		if got := IsBlank(tt.in); got != tt.isBlank {
+			t.Errorf("IsBlank(%q) = %v", tt.in, got)
+		}
+	}
+}
+
+func TestContainsOnly(t *testing.T) {
+	tests := []struct {
+		s, charset string
+		want       bool
+	}{
+		{"abba", "ab", true},
+		{"abc", "ab", false},
+		{"", "ab", true},
+		{"", "", true},
+		{"a", "", false},
+		{"กขก", "กข", true},
+		{"0812-345", "0123456789-", true},
+	}
+
+	for _, tt := range tests {
+		if got := ContainsOnly(tt.s, tt.charset); got != tt.want {
+			t.Errorf("ContainsOnly(%q, %q) = %v", tt.s, tt.charset, got)
+		}
+	}
+}
+
+func TestExtractDigits(t *testing.T) {
+	tests := []struct {
+		in, want string
+	}{
+		{"+66 (81) 234-5678", "66812345678"},
+		{"no digits", ""},
+		{"", ""},
+		{"๑๒3", "3"},
+		{"１2", "2"},
+	}
+
+	for _, tt := range tests {
+		if got := ExtractDigits(tt.in); got != tt.want {
+			t.Errorf("ExtractDigits(%q) = %q, want %q", tt.in, got, tt.want)
+		}
+	}
+}